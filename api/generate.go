@@ -0,0 +1,85 @@
+// Package api lets gqlgen be run as a library, with extra plugins hooked into code generation.
+package api
+
+import (
+	"io/ioutil"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/pkg/errors"
+)
+
+type Option func(cfg *codegen.Config, plugins *[]codegen.Plugin)
+
+// Generate reads the schema files named in the config and generates code for them using the default plugins,
+// along with any plugins added by the options.
+func Generate(cfg *codegen.Config, option ...Option) error {
+	plugins := codegen.DefaultPlugins()
+	for _, o := range option {
+		o(cfg, &plugins)
+	}
+
+	if err := loadSchema(cfg); err != nil {
+		return err
+	}
+
+	return codegen.GenerateWithPlugins(*cfg, plugins)
+}
+
+// GenerateClient only generates the typed client for the operations in the client config, the schema can belong
+// to another service
+func GenerateClient(cfg *codegen.Config) error {
+	if err := loadSchema(cfg); err != nil {
+		return err
+	}
+
+	return codegen.GenerateClient(*cfg)
+}
+
+func loadSchema(cfg *codegen.Config) error {
+	if cfg.SchemaStr == nil {
+		cfg.SchemaStr = map[string]string{}
+	}
+	for _, filename := range cfg.SchemaFilename {
+		if _, loaded := cfg.SchemaStr[filename]; loaded {
+			continue
+		}
+		schemaRaw, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return errors.Wrap(err, "unable to open schema")
+		}
+		cfg.SchemaStr[filename] = string(schemaRaw)
+	}
+
+	if err := cfg.Check(); err != nil {
+		return errors.Wrap(err, "invalid config format")
+	}
+	return nil
+}
+
+// AddPlugin runs a plugin after the default plugins
+func AddPlugin(p codegen.Plugin) Option {
+	return func(cfg *codegen.Config, plugins *[]codegen.Plugin) {
+		*plugins = append(*plugins, p)
+	}
+}
+
+// ReplacePlugin replaces the plugin with the same name, eg to provide a custom resolver generator. The plugin is
+// added if none of the current plugins have the same name.
+func ReplacePlugin(p codegen.Plugin) Option {
+	return func(cfg *codegen.Config, plugins *[]codegen.Plugin) {
+		for i, existing := range *plugins {
+			if existing.Name() == p.Name() {
+				(*plugins)[i] = p
+				return
+			}
+		}
+		*plugins = append(*plugins, p)
+	}
+}
+
+// NoPlugins removes all the plugins added so far, including the defaults
+func NoPlugins() Option {
+	return func(cfg *codegen.Config, plugins *[]codegen.Plugin) {
+		*plugins = nil
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+// Transport sends the requests of a generated client, decoding the data of the response into response. Any graphql
+// errors in the response should be returned as Errors, after decoding whatever data came back with them.
+type Transport interface {
+	Do(ctx context.Context, request *Request, response interface{}) error
+}
+
+// TransportFunc lets a function be used as a Transport, eg to call an executable schema in the same process
+type TransportFunc func(ctx context.Context, request *Request, response interface{}) error
+
+func (f TransportFunc) Do(ctx context.Context, request *Request, response interface{}) error {
+	return f(ctx, request, response)
+}
+
+// HTTPTransport posts requests as json, the way the gqlgen handler expects them
+type HTTPTransport struct {
+	URL string
+	// Client sends the requests, defaults to http.DefaultClient
+	Client *http.Client
+	// Header is added to every request, eg for authorization
+	Header http.Header
+}
+
+func (t *HTTPTransport) Do(ctx context.Context, request *Request, response interface{}) error {
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("encode: %s", err.Error())
+	}
+
+	httpRequest, err := http.NewRequest(http.MethodPost, t.URL, bytes.NewReader(requestBody))
+	if err != nil {
+		return fmt.Errorf("post: %s", err.Error())
+	}
+	httpRequest = httpRequest.WithContext(ctx)
+	for name, values := range t.Header {
+		httpRequest.Header[name] = values
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpClient := t.Client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return fmt.Errorf("post: %s", err.Error())
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	responseBody, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("read: %s", err.Error())
+	}
+
+	// errors such as validation failures come back with an error status, but still have a graphql response body
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors Errors          `json:"errors"`
+	}
+	if err := json.Unmarshal(responseBody, &result); err != nil || result.Data == nil && result.Errors == nil {
+		if httpResponse.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("http %d: %s", httpResponse.StatusCode, responseBody)
+		}
+		if err != nil {
+			return fmt.Errorf("decode: %s", err.Error())
+		}
+	}
+
+	// we want to decode even if there are errors, so partial responses can be used
+	if len(result.Data) > 0 && string(result.Data) != "null" {
+		if err := json.Unmarshal(result.Data, response); err != nil {
+			return fmt.Errorf("decode: %s", err.Error())
+		}
+	}
+	if len(result.Errors) > 0 {
+		return result.Errors
+	}
+	return nil
+}
+
+// Errors are the graphql errors in a response
+type Errors []*gqlerror.Error
+
+func (errs Errors) Error() string {
+	var messages []string
+	for _, err := range errs {
+		var path []string
+		for _, p := range err.Path {
+			path = append(path, fmt.Sprint(p))
+		}
+		if len(path) > 0 {
+			messages = append(messages, strings.Join(path, ".")+": "+err.Message)
+		} else {
+			messages = append(messages, err.Message)
+		}
+	}
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,58 @@
+package client_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			panic(err)
+		}
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		switch string(b) {
+		case `{"query":"query User($id: ID!) { user(id: $id) { name } }","variables":{"id":1},"operationName":"User"}`:
+			_, _ = w.Write([]byte(`{"data":{"user":{"name":"bob"}}}`))
+		case `{"query":"{ user(id: 2) { name } friends }"}`:
+			_, _ = w.Write([]byte(`{"data":{"user":{"name":"alice"},"friends":null},"errors":[{"message":"no friends","path":["friends"]}]}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("bad gateway"))
+		}
+	}))
+	transport := &client.HTTPTransport{URL: h.URL, Header: http.Header{"Authorization": {"Bearer token"}}}
+
+	var resp struct {
+		User struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+	err := transport.Do(context.Background(), &client.Request{
+		Query:         "query User($id: ID!) { user(id: $id) { name } }",
+		Variables:     map[string]interface{}{"id": 1},
+		OperationName: "User",
+	}, &resp)
+	require.NoError(t, err)
+	require.Equal(t, "bob", resp.User.Name)
+
+	t.Run("errors come back with the partial response", func(t *testing.T) {
+		err := transport.Do(context.Background(), &client.Request{Query: "{ user(id: 2) { name } friends }"}, &resp)
+		require.EqualError(t, err, "friends: no friends")
+		require.Equal(t, "alice", resp.User.Name)
+	})
+
+	t.Run("http errors", func(t *testing.T) {
+		err := transport.Do(context.Background(), &client.Request{Query: "{ nope }"}, &resp)
+		require.EqualError(t, err, "http 502: bad gateway")
+	})
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/urfave/cli"
+)
+
+var clientCmd = cli.Command{
+	Name:      "client",
+	Usage:     "generate a typed client for the operations in .graphql files, without generating the server",
+	ArgsUsage: "[operations...]",
+	Flags: []cli.Flag{
+		cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		cli.StringFlag{Name: "filename", Usage: "where to write the client, when the config doesn't have a client section"},
+	},
+	Action: func(ctx *cli.Context) {
+		config := loadConfig(ctx)
+
+		if !config.Client.IsDefined() {
+			config.Client.Filename = ctx.String("filename")
+			if config.Client.Filename == "" {
+				config.Client.Filename = "client/client_gen.go"
+			}
+		}
+		if ctx.NArg() > 0 {
+			config.Client.Operations = codegen.SchemaFilenames(ctx.Args())
+		}
+
+		if err := api.GenerateClient(config); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(2)
+		}
+	},
+}
@@ -2,9 +2,9 @@ package cmd
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 
+	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
@@ -18,43 +18,33 @@ var genCmd = cli.Command{
 		cli.StringFlag{Name: "config, c", Usage: "the config filename"},
 	},
 	Action: func(ctx *cli.Context) {
-		var config *codegen.Config
-		var err error
-		if configFilename := ctx.String("config"); configFilename != "" {
-			config, err = codegen.LoadConfig(configFilename)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err.Error())
-				os.Exit(1)
-			}
-		} else {
-			config, err = codegen.LoadConfigFromDefaultLocations()
-			if os.IsNotExist(errors.Cause(err)) {
-				config = codegen.DefaultConfig()
-			} else if err != nil {
-				fmt.Fprintln(os.Stderr, err.Error())
-				os.Exit(1)
-			}
-		}
+		config := loadConfig(ctx)
 
-		for _, filename := range config.SchemaFilename {
-			var schemaRaw []byte
-			schemaRaw, err = ioutil.ReadFile(filename)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, "unable to open schema: "+err.Error())
-				os.Exit(1)
-			}
-			config.SchemaStr[filename] = string(schemaRaw)
+		if err := api.Generate(config); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(2)
 		}
+	},
+}
 
-		if err = config.Check(); err != nil {
-			fmt.Fprintln(os.Stderr, "invalid config format: "+err.Error())
+// loadConfig loads the config named by the --config flag, or from the default locations
+func loadConfig(ctx *cli.Context) *codegen.Config {
+	var config *codegen.Config
+	var err error
+	if configFilename := ctx.String("config"); configFilename != "" {
+		config, err = codegen.LoadConfig(configFilename)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
 			os.Exit(1)
 		}
-
-		err = codegen.Generate(*config)
-		if err != nil {
+	} else {
+		config, err = codegen.LoadConfigFromDefaultLocations()
+		if os.IsNotExist(errors.Cause(err)) {
+			config = codegen.DefaultConfig()
+		} else if err != nil {
 			fmt.Fprintln(os.Stderr, err.Error())
-			os.Exit(2)
+			os.Exit(1)
 		}
-	},
+	}
+	return config
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
@@ -69,21 +70,7 @@ var initCmd = cli.Command{
 }
 
 func GenerateGraphServer(config *codegen.Config, serverFilename string) {
-	for _, filename := range config.SchemaFilename {
-		schemaRaw, err := ioutil.ReadFile(filename)
-		if err != nil {
-			fmt.Fprintln(os.Stderr, "unable to open schema: "+err.Error())
-			os.Exit(1)
-		}
-		config.SchemaStr[filename] = string(schemaRaw)
-	}
-
-	if err := config.Check(); err != nil {
-		fmt.Fprintln(os.Stderr, "invalid config format: "+err.Error())
-		os.Exit(1)
-	}
-
-	if err := codegen.Generate(*config); err != nil {
+	if err := api.Generate(config); err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/99designs/gqlgen/api"
+	"github.com/urfave/cli"
+)
+
+var mockCmd = cli.Command{
+	Name:  "mock",
+	Usage: "generate the server along with a mock resolver that returns fake data",
+	Flags: []cli.Flag{
+		cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		cli.StringFlag{Name: "filename", Usage: "where to write the mock resolver, when the config doesn't have a mock section"},
+		cli.IntFlag{Name: "listSize", Usage: "the number of items in each list, when the config doesn't set one"},
+	},
+	Action: func(ctx *cli.Context) {
+		config := loadConfig(ctx)
+
+		if !config.Mock.IsDefined() {
+			config.Mock.Filename = ctx.String("filename")
+			if config.Mock.Filename == "" {
+				config.Mock.Filename = filepath.Join(filepath.Dir(config.Exec.Filename), "mock_gen.go")
+			}
+		}
+		if config.Mock.ListSize == 0 {
+			config.Mock.ListSize = ctx.Int("listSize")
+		}
+
+		if err := api.Generate(config); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(2)
+		}
+	},
+}
@@ -7,7 +7,6 @@ import (
 	"os"
 
 	"github.com/99designs/gqlgen/graphql"
-	"github.com/99designs/gqlgen/internal/gopath"
 	"github.com/urfave/cli"
 
 	// Required since otherwise dep will prune away these unused packages before codegen has a chance to run
@@ -23,14 +22,6 @@ func Execute() {
 	app.Flags = genCmd.Flags
 	app.Version = graphql.Version
 	app.Before = func(context *cli.Context) error {
-		pwd, err := os.Getwd()
-		if err != nil {
-			return fmt.Errorf("unable to determine current workding dir: %s\n", err.Error())
-		}
-
-		if !gopath.Contains(pwd) {
-			return fmt.Errorf("gqlgen must be run from inside your $GOPATH\n")
-		}
 		if context.Bool("verbose") {
 			log.SetFlags(0)
 		} else {
@@ -42,7 +33,9 @@ func Execute() {
 	app.Action = genCmd.Action
 	app.Commands = []cli.Command{
 		genCmd,
+		clientCmd,
 		initCmd,
+		mockCmd,
 		versionCmd,
 	}
 
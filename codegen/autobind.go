@@ -0,0 +1,50 @@
+package codegen
+
+import (
+	"go/types"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// autobind maps schema types without a model to a go type with the same name in one of the autobind packages, or
+// to a pair of Marshal/Unmarshal functions for scalars. Packages are searched in the order they are listed.
+func (cfg *Config) autobind() error {
+	if len(cfg.AutoBind) == 0 {
+		return nil
+	}
+
+	if err := cfg.loadPackages(); err != nil {
+		return err
+	}
+
+	var typeNames []string
+	for name := range cfg.schema.Types {
+		if cfg.Models.Exists(name) || strings.HasPrefix(name, "__") {
+			continue
+		}
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		for _, importPath := range cfg.AutoBind {
+			pkg := cfg.pkgs.get(importPath)
+			if pkg == nil || pkg.Types == nil {
+				return errors.Errorf("unable to load autobind package %s", importPath)
+			}
+
+			_, isType := pkg.Types.Scope().Lookup(typeName).(*types.TypeName)
+			_, isMarshaler := pkg.Types.Scope().Lookup("Marshal" + typeName).(*types.Func)
+			if isType || isMarshaler {
+				cfg.Models[typeName] = TypeMapEntry{Model: importPath + "." + typeName}
+				log.Printf("autobind: bound %s to %s.%s\n", typeName, importPath, typeName)
+				break
+			}
+		}
+	}
+
+	return nil
+}
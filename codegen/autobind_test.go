@@ -0,0 +1,31 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestAutobind(t *testing.T) {
+	cfg := Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			type Query { user: User, post: Post }
+			type User { id: Int!, name: String! }
+			type Post { title: String! }
+		`},
+		Exec:     PackageConfig{Filename: "gen/autobind/exec.go"},
+		Model:    ModelConfig{PackageConfig: PackageConfig{Filename: "gen/autobind/model.go"}},
+		AutoBind: []string{"github.com/99designs/gqlgen/codegen/testdata/autobinding"},
+	}
+
+	err := Generate(cfg)
+	require.NoError(t, err)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/autobind")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+	require.Nil(t, pkgs[0].Types.Scope().Lookup("User"))
+	require.NotNil(t, pkgs[0].Types.Scope().Lookup("Post"))
+}
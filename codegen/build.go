@@ -2,12 +2,9 @@ package codegen
 
 import (
 	"fmt"
-	"go/build"
 	"go/types"
-	"os"
 
 	"github.com/pkg/errors"
-	"golang.org/x/tools/go/loader"
 )
 
 type Build struct {
@@ -15,12 +12,30 @@ type Build struct {
 	Objects          Objects
 	Inputs           Objects
 	Interfaces       []*Interface
+	BoundEnums       []*BoundEnum
 	QueryRoot        *Object
 	MutationRoot     *Object
 	SubscriptionRoot *Object
 	SchemaRaw        map[string]string
 	SchemaFilename   SchemaFilenames
 	Directives       []*Directive
+	Relay            *Relay
+	Federation       *Federation
+
+	// the field middleware only runs the directives on objects and on enum values used in arguments when the schema
+	// has any with a DirectiveRoot entry
+	HasObjectDirectives    bool
+	HasEnumValueDirectives bool
+
+	// with the follow-schema layout each file only contains the types declared in one schema file, the rest of the
+	// executable schema is written to the root file
+	followSchema bool
+	root         bool
+	filename     string
+	declaredIn   map[string]string
+
+	// the bound types, so that the other generators don't have to bind the schema again
+	namedTypes NamedTypes
 }
 
 type ModelBuild struct {
@@ -29,11 +44,25 @@ type ModelBuild struct {
 	Enums       []Enum
 }
 
+type LoaderBuild struct {
+	PackageName string
+	Loaders     []*Loader
+}
+
 type ResolverBuild struct {
 	PackageName   string
 	ResolverType  string
 	Objects       Objects
 	ResolverFound bool
+
+	existing          *existingResolver
+	implementations   map[string]*existingMethod
+	declaredElsewhere map[string]bool
+
+	// with the follow-schema layout each resolver file only contains the types and fields declared in one schema file
+	followSchema bool
+	schemaFile   string
+	declaredIn   map[string]string
 }
 
 type ServerBuild struct {
@@ -46,16 +75,13 @@ type ServerBuild struct {
 func (cfg *Config) models() (*ModelBuild, error) {
 	namedTypes := cfg.buildNamedTypes()
 
-	progLoader := cfg.newLoaderWithoutErrors()
-
-	prog, err := progLoader.Load()
-	if err != nil {
-		return nil, errors.Wrap(err, "loading failed")
+	if err := cfg.loadPackages(); err != nil {
+		return nil, err
 	}
 
-	cfg.bindTypes(namedTypes, cfg.Model.Dir(), prog)
+	cfg.bindTypes(namedTypes, cfg.Model.Dir(), cfg.pkgs)
 
-	models, err := cfg.buildModels(namedTypes, prog)
+	models, err := cfg.buildModels(namedTypes, cfg.pkgs)
 	if err != nil {
 		return nil, err
 	}
@@ -66,36 +92,94 @@ func (cfg *Config) models() (*ModelBuild, error) {
 	}, nil
 }
 
-// bind a schema together with some code to generate a Build
-func (cfg *Config) resolver() (*ResolverBuild, error) {
-	progLoader := cfg.newLoaderWithoutErrors()
-	progLoader.Import(cfg.Resolver.ImportPath())
-
-	prog, err := progLoader.Load()
+// Create a list of dataloaders that need to be generated
+func (cfg *Config) loaders(exec *Build) (*LoaderBuild, error) {
+	loaders, err := cfg.buildLoaders(exec.namedTypes)
 	if err != nil {
 		return nil, err
 	}
 
-	destDir := cfg.Resolver.Dir()
-
-	namedTypes := cfg.buildNamedTypes()
-
-	cfg.bindTypes(namedTypes, destDir, prog)
+	return &LoaderBuild{
+		PackageName: cfg.Exec.Package,
+		Loaders:     loaders,
+	}, nil
+}
 
-	objects, err := cfg.buildObjects(namedTypes, prog)
-	if err != nil {
-		return nil, err
+// Create the resolver stubs for the objects in the exec build
+func (cfg *Config) resolver(exec *Build) (*ResolverBuild, error) {
+	objects := append(Objects{}, exec.Objects...)
+	if exec.Relay != nil && exec.Relay.Resolver.HasResolvers() {
+		objects = append(objects, exec.Relay.Resolver)
+	}
+	if exec.Federation != nil && exec.Federation.Resolver.HasResolvers() {
+		objects = append(objects, exec.Federation.Resolver)
 	}
 
-	def, _ := findGoType(prog, cfg.Resolver.ImportPath(), cfg.Resolver.Type)
+	def, _ := findGoType(cfg.pkgs, cfg.Resolver.ImportPath(), cfg.Resolver.Type)
 	resolverFound := def != nil
 
-	return &ResolverBuild{
+	build := &ResolverBuild{
 		PackageName:   cfg.Resolver.Package,
 		Objects:       objects,
 		ResolverType:  cfg.Resolver.Type,
 		ResolverFound: resolverFound,
-	}, nil
+	}
+
+	if cfg.Resolver.Layout == LayoutSingleFile {
+		var err error
+		build.existing, err = parseExistingResolver(cfg.Resolver.Filename, cfg.Resolver.Type)
+		if err != nil {
+			return nil, err
+		}
+		if build.existing != nil {
+			build.implementations = build.existing.methods
+		}
+		build.keepOtherMethods()
+
+		build.declaredElsewhere = cfg.declaredOutside(cfg.Resolver.ImportPath(), cfg.Resolver.Filename)
+	}
+
+	return build, nil
+}
+
+// declaredOutside finds the types and methods in a package that are declared in files other than the given files,
+// keyed by type name or Type.Method
+func (cfg *Config) declaredOutside(importPath string, filenames ...string) map[string]bool {
+	declared := map[string]bool{}
+
+	pkg := cfg.pkgs.get(importPath)
+	if pkg == nil || pkg.Types == nil {
+		return declared
+	}
+
+	inside := map[string]bool{}
+	for _, filename := range filenames {
+		inside[abs(filename)] = true
+	}
+	outside := func(obj types.Object) bool {
+		pos := pkg.Fset.Position(obj.Pos())
+		return pos.Filename != "" && !inside[abs(pos.Filename)]
+	}
+
+	scope := pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		if outside(typeName) {
+			declared[name] = true
+		}
+		if named, ok := typeName.Type().(*types.Named); ok {
+			for i := 0; i < named.NumMethods(); i++ {
+				if method := named.Method(i); outside(method) {
+					declared[methodKey(name, method.Name())] = true
+				}
+			}
+		}
+	}
+
+	return declared
 }
 
 func (cfg *Config) server(destDir string) *ServerBuild {
@@ -110,20 +194,18 @@ func (cfg *Config) server(destDir string) *ServerBuild {
 func (cfg *Config) bind() (*Build, error) {
 	namedTypes := cfg.buildNamedTypes()
 
-	progLoader := cfg.newLoaderWithoutErrors()
-	prog, err := progLoader.Load()
-	if err != nil {
-		return nil, errors.Wrap(err, "loading failed")
+	if err := cfg.loadPackages(); err != nil {
+		return nil, err
 	}
 
-	cfg.bindTypes(namedTypes, cfg.Exec.Dir(), prog)
+	cfg.bindTypes(namedTypes, cfg.Exec.Dir(), cfg.pkgs)
 
-	objects, err := cfg.buildObjects(namedTypes, prog)
+	objects, err := cfg.buildObjects(namedTypes, cfg.pkgs)
 	if err != nil {
 		return nil, err
 	}
 
-	inputs, err := cfg.buildInputs(namedTypes, prog)
+	inputs, err := cfg.buildInputs(namedTypes, cfg.pkgs)
 	if err != nil {
 		return nil, err
 	}
@@ -131,16 +213,55 @@ func (cfg *Config) bind() (*Build, error) {
 	if err != nil {
 		return nil, err
 	}
+	boundEnums, err := cfg.buildBoundEnums(namedTypes, cfg.pkgs)
+	if err != nil {
+		return nil, err
+	}
+	interfaces, err := cfg.buildInterfaces(namedTypes, cfg.pkgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var relay *Relay
+	if cfg.Relay {
+		relay, err = cfg.buildRelay(namedTypes, objects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	schemaRaw := map[string]string{}
+	for filename, input := range cfg.SchemaStr {
+		stripped, err := stripCodegenDirectives(input)
+		if err != nil {
+			return nil, errors.Wrap(err, filename)
+		}
+		schemaRaw[filename] = stripped
+	}
+
+	var federation *Federation
+	if cfg.Federation {
+		federation, err = cfg.buildFederation(namedTypes, objects)
+		if err != nil {
+			return nil, err
+		}
+		federation.SDL = federationSDL(schemaRaw)
+	}
 
 	b := &Build{
 		PackageName:    cfg.Exec.Package,
 		Objects:        objects,
-		Interfaces:     cfg.buildInterfaces(namedTypes, prog),
+		Interfaces:     interfaces,
 		Inputs:         inputs,
-		SchemaRaw:      cfg.SchemaStr,
+		BoundEnums:     boundEnums,
+		SchemaRaw:      schemaRaw,
 		SchemaFilename: cfg.SchemaFilename,
 		Directives:     directives,
+		Relay:          relay,
+		Federation:     federation,
+		namedTypes:     namedTypes,
 	}
+	b.HasObjectDirectives, b.HasEnumValueDirectives = cfg.hasSchemaDirectives()
 
 	if cfg.schema.Query != nil {
 		b.QueryRoot = b.Objects.ByName(cfg.schema.Query.Name)
@@ -158,37 +279,28 @@ func (cfg *Config) bind() (*Build, error) {
 	return b, nil
 }
 
+// validate the generated code compiles, only the exec package is loaded again
 func (cfg *Config) validate() error {
-	progLoader := cfg.newLoaderWithErrors()
-	_, err := progLoader.Load()
-	return err
-}
-
-func (cfg *Config) newLoaderWithErrors() loader.Config {
-	conf := loader.Config{}
-
-	for _, pkg := range cfg.Models.referencedPackages() {
-		conf.Import(pkg)
+	exec := newPkgLoader()
+	if err := exec.load(cfg.Exec.ImportPath()); err != nil {
+		return err
 	}
-	return conf
-}
 
-func (cfg *Config) newLoaderWithoutErrors() loader.Config {
-	conf := cfg.newLoaderWithErrors()
-	conf.AllowErrors = true
-	conf.TypeChecker = types.Config{
-		Error: func(e error) {},
-	}
-	return conf
+	return exec.errors(cfg.Exec.ImportPath())
 }
 
-func resolvePkg(pkgName string) (string, error) {
-	cwd, _ := os.Getwd()
+// loadPackages loads every package referenced by the config along with the output and autobind packages. Nothing is
+// loaded again until the packages are evicted.
+func (cfg *Config) loadPackages() error {
+	if cfg.pkgs == nil {
+		cfg.pkgs = newPkgLoader()
+	}
 
-	pkg, err := build.Default.Import(pkgName, cwd, build.FindOnly)
-	if err != nil {
-		return "", err
+	pkgs := append(cfg.Models.referencedPackages(), cfg.Model.ImportPath(), cfg.Exec.ImportPath())
+	if cfg.Resolver.IsDefined() {
+		pkgs = append(pkgs, cfg.Resolver.ImportPath())
 	}
+	pkgs = append(pkgs, cfg.AutoBind...)
 
-	return pkg.ImportPath, nil
+	return cfg.pkgs.load(pkgs...)
 }
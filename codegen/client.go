@@ -0,0 +1,525 @@
+package codegen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/parser"
+	"github.com/vektah/gqlparser/validator"
+)
+
+// clientScalars are the go types the client decodes the builtin scalars into, other scalars default to json.RawMessage
+var clientScalars = map[string]string{
+	"String":  "string",
+	"ID":      "string",
+	"Int":     "int",
+	"Float":   "float64",
+	"Boolean": "bool",
+	"Time":    "time.Time",
+	"Map":     "map[string]interface{}",
+}
+
+// clientReserved are the names used by the generated methods, variables with these names get a suffix
+var clientReserved = []string{"c", "ctx", "vars", "resp", "err"}
+
+// ClientBuild is a typed client, with a method for each operation
+type ClientBuild struct {
+	PackageName string
+	ClientType  string
+	Operations  []*ClientOperation
+	Structs     []*ClientStruct // the response types, each nested selection set gets its own type
+	Inputs      []*ClientStruct // the input types used by the variables
+	Enums       []*ClientEnum
+}
+
+type ClientOperation struct {
+	Name      string // the go name, used for the method, the response type and the document
+	GQLName   string
+	Operation ast.Operation
+	Document  string // the source of the operation, followed by the fragments it uses
+	Variables []*ClientVariable
+	Response  *ClientStruct
+}
+
+type ClientVariable struct {
+	GQLName   string
+	GoVarName string
+	Type      *ClientType
+	// Optional variables are left out of the request when they are nil, so the server can use their default
+	Optional bool
+}
+
+type ClientStruct struct {
+	Name        string
+	Description string
+	Fields      []*ClientField
+}
+
+type ClientField struct {
+	GoName      string
+	Description string
+	Type        *ClientType
+	Tag         string
+}
+
+type ClientEnum struct {
+	Name        string
+	Description string
+	Values      []ClientEnumValue
+}
+
+type ClientEnumValue struct {
+	Name        string
+	Description string
+	Value       string
+}
+
+// ClientType is a go type in the client, it is only written out once the imports of the client are known
+type ClientType struct {
+	Modifiers []string
+	Ref       Ref
+}
+
+func (t *ClientType) String() string {
+	return strings.Join(t.Modifiers, "") + t.Ref.FullName()
+}
+
+// GenerateClient only generates the client for the operations in config.client, eg to call a service from another
+// service that has a copy of its schema
+func GenerateClient(cfg Config) error {
+	if !cfg.Client.IsDefined() {
+		return errors.New("client: filename is required")
+	}
+	if err := cfg.normalize(); err != nil {
+		return err
+	}
+	return cfg.generateClient()
+}
+
+func (cfg *Config) generateClient() error {
+	build, err := cfg.client()
+	if err != nil {
+		return errors.Wrap(err, "client build failed")
+	}
+	return templates.RenderToFile("client.gotpl", cfg.Client.Filename, build)
+}
+
+type clientBuilder struct {
+	cfg    *Config
+	doc    *ast.QueryDocument
+	build  *ClientBuild
+	names  map[string]string // the go type names already used, and what they were used for
+	inputs map[string]bool
+	enums  map[string]bool
+}
+
+// client validates the operations against the schema, and builds the types and methods to send them
+func (cfg *Config) client() (*ClientBuild, error) {
+	sources, err := cfg.Client.operationSources()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &ast.QueryDocument{}
+	for _, source := range sources {
+		parsed, parseErr := parser.ParseQuery(source)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		doc.Operations = append(doc.Operations, parsed.Operations...)
+		doc.Fragments = append(doc.Fragments, parsed.Fragments...)
+	}
+	if errs := validator.Validate(cfg.schema, doc); len(errs) > 0 {
+		return nil, errs
+	}
+	definitions := definitionSources(sources, doc)
+
+	b := &clientBuilder{
+		cfg: cfg,
+		doc: doc,
+		build: &ClientBuild{
+			PackageName: cfg.Client.Package,
+			ClientType:  cfg.Client.Type,
+		},
+		names:  map[string]string{},
+		inputs: map[string]bool{},
+		enums:  map[string]bool{},
+	}
+	if err := b.claim(cfg.Client.Type, "the client"); err != nil {
+		return nil, err
+	}
+
+	for _, op := range doc.Operations {
+		if op.Name == "" {
+			return nil, errors.Errorf("operations sent by the client must be named")
+		}
+		if op.Operation == ast.Subscription {
+			return nil, errors.Errorf("subscription %s: subscriptions are not supported by the client", op.Name)
+		}
+
+		operation := &ClientOperation{
+			Name:      clientGoName(op.Name),
+			GQLName:   op.Name,
+			Operation: op.Operation,
+		}
+		if err := b.claim(operation.Name+"Document", op.Name); err != nil {
+			return nil, err
+		}
+
+		document := []string{definitions[op.Position]}
+		for _, fragment := range b.usedFragments(op.SelectionSet, map[string]bool{}) {
+			document = append(document, definitions[fragment.Position])
+		}
+		operation.Document = strings.Join(document, "\n\n")
+
+		for _, v := range op.VariableDefinitions {
+			typ := v.Type
+			if v.DefaultValue != nil && typ.NonNull {
+				// a variable with a default can be left out, so it is optional in go too
+				nullable := *typ
+				nullable.NonNull = false
+				typ = &nullable
+			}
+			goType, err := b.inputType(typ)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s $%s", op.Name, v.Variable)
+			}
+			operation.Variables = append(operation.Variables, &ClientVariable{
+				GQLName:   v.Variable,
+				GoVarName: clientVarName(v.Variable),
+				Type:      goType,
+				Optional:  !typ.NonNull,
+			})
+		}
+
+		operation.Response, err = b.selectionStruct(operation.Name+"Response", op.Name, op.SelectionSet)
+		if err != nil {
+			return nil, err
+		}
+		b.build.Operations = append(b.build.Operations, operation)
+	}
+
+	sort.Slice(b.build.Inputs, func(i, j int) bool {
+		return b.build.Inputs[i].Name < b.build.Inputs[j].Name
+	})
+	sort.Slice(b.build.Enums, func(i, j int) bool {
+		return b.build.Enums[i].Name < b.build.Enums[j].Name
+	})
+	return b.build, nil
+}
+
+// operationSources reads the operation files, along with any operations passed in directly
+func (c *ClientConfig) operationSources() ([]*ast.Source, error) {
+	var sources []*ast.Source
+	for _, filename := range c.Operations {
+		input, loaded := c.OperationStr[filename]
+		if !loaded {
+			raw, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to open operations")
+			}
+			input = string(raw)
+		}
+		sources = append(sources, &ast.Source{Name: filename, Input: input})
+	}
+
+	var extra []string
+	for filename := range c.OperationStr {
+		if !c.Operations.Has(filename) {
+			extra = append(extra, filename)
+		}
+	}
+	sort.Strings(extra)
+	for _, filename := range extra {
+		sources = append(sources, &ast.Source{Name: filename, Input: c.OperationStr[filename]})
+	}
+	return sources, nil
+}
+
+// definitionSources slices the source of each operation and fragment out of the file it is declared in, so the
+// documents the client sends read the same as the files they were written in
+func definitionSources(sources []*ast.Source, doc *ast.QueryDocument) map[*ast.Position]string {
+	var positions []*ast.Position
+	for _, op := range doc.Operations {
+		positions = append(positions, op.Position)
+	}
+	for _, fragment := range doc.Fragments {
+		positions = append(positions, fragment.Position)
+	}
+
+	definitions := map[*ast.Position]string{}
+	for _, source := range sources {
+		var inSource []*ast.Position
+		for _, pos := range positions {
+			if pos.Src == source {
+				inSource = append(inSource, pos)
+			}
+		}
+		sort.Slice(inSource, func(i, j int) bool {
+			return inSource[i].Start < inSource[j].Start
+		})
+
+		input := []rune(source.Input)
+		for i, pos := range inSource {
+			end := len(input)
+			if i+1 < len(inSource) {
+				end = inSource[i+1].Start
+			}
+			lines := strings.Split(strings.TrimSpace(string(input[pos.Start:end])), "\n")
+			// comments before the next definition belong to it, not to this one
+			for len(lines) > 1 && strings.HasPrefix(strings.TrimSpace(lines[len(lines)-1]), "#") {
+				lines = lines[:len(lines)-1]
+			}
+			definitions[pos] = strings.TrimSpace(strings.Join(lines, "\n"))
+		}
+	}
+	return definitions
+}
+
+// usedFragments are the fragments a selection set spreads, directly or through other fragments, sorted by name
+func (b *clientBuilder) usedFragments(selectionSet ast.SelectionSet, seen map[string]bool) []*ast.FragmentDefinition {
+	var fragments []*ast.FragmentDefinition
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fragments = append(fragments, b.usedFragments(selection.SelectionSet, seen)...)
+		case *ast.InlineFragment:
+			fragments = append(fragments, b.usedFragments(selection.SelectionSet, seen)...)
+		case *ast.FragmentSpread:
+			if seen[selection.Name] {
+				continue
+			}
+			seen[selection.Name] = true
+			fragment := b.doc.Fragments.ForName(selection.Name)
+			fragments = append(fragments, fragment)
+			fragments = append(fragments, b.usedFragments(fragment.SelectionSet, seen)...)
+		}
+	}
+	sort.Slice(fragments, func(i, j int) bool {
+		return fragments[i].Name < fragments[j].Name
+	})
+	return fragments
+}
+
+// claim reserves a go type name, so two things can't be generated with the same name
+func (b *clientBuilder) claim(name string, usedFor string) error {
+	if existing, ok := b.names[name]; ok {
+		return errors.Errorf("%s and %s would both generate %s, rename one of them or use an alias", existing, usedFor, name)
+	}
+	b.names[name] = usedFor
+	return nil
+}
+
+type responseField struct {
+	alias        string
+	definition   *ast.FieldDefinition
+	selectionSet ast.SelectionSet
+}
+
+// collectFields flattens the fields of a selection set, merging the fields selected through fragments. Fields from
+// fragments on other types are left as their zero value when the response is for a different type.
+func (b *clientBuilder) collectFields(selectionSet ast.SelectionSet, fields []*responseField) []*responseField {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			var field *responseField
+			for _, f := range fields {
+				if f.alias == selection.Alias {
+					field = f
+				}
+			}
+			if field == nil {
+				field = &responseField{alias: selection.Alias, definition: selection.Definition}
+				fields = append(fields, field)
+			}
+			field.selectionSet = append(field.selectionSet, selection.SelectionSet...)
+		case *ast.InlineFragment:
+			fields = b.collectFields(selection.SelectionSet, fields)
+		case *ast.FragmentSpread:
+			fields = b.collectFields(b.doc.Fragments.ForName(selection.Name).SelectionSet, fields)
+		}
+	}
+	return fields
+}
+
+// selectionStruct is the response type for a selection set, path describes where it is for errors
+func (b *clientBuilder) selectionStruct(name string, path string, selectionSet ast.SelectionSet) (*ClientStruct, error) {
+	if err := b.claim(name, path); err != nil {
+		return nil, err
+	}
+	s := &ClientStruct{Name: name}
+	b.build.Structs = append(b.build.Structs, s)
+
+	goNames := map[string]string{}
+	for _, field := range b.collectFields(selectionSet, nil) {
+		goName := clientGoName(field.alias)
+		if field.alias == "__typename" {
+			goName = "Typename"
+		}
+		if existing, ok := goNames[goName]; ok {
+			return nil, errors.Errorf("%s: %s and %s would both be %s, use an alias", path, existing, field.alias, goName)
+		}
+		goNames[goName] = field.alias
+
+		var goType *ClientType
+		var err error
+		if field.alias == "__typename" && field.definition.Name == "__typename" {
+			goType = &ClientType{Ref: Ref{GoType: "string"}}
+		} else {
+			goType, err = b.responseType(name+goName, path+"."+field.alias, field.definition.Type, field.selectionSet)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		s.Fields = append(s.Fields, &ClientField{
+			GoName:      goName,
+			Description: field.definition.Description,
+			Type:        goType,
+			Tag:         fmt.Sprintf(`json:"%s"`, field.alias),
+		})
+	}
+	return s, nil
+}
+
+func (b *clientBuilder) responseType(name string, path string, t *ast.Type, selectionSet ast.SelectionSet) (*ClientType, error) {
+	def := b.cfg.schema.Types[t.Name()]
+	switch def.Kind {
+	case ast.Object, ast.Interface, ast.Union:
+		s, err := b.selectionStruct(name, path, selectionSet)
+		if err != nil {
+			return nil, err
+		}
+		return clientType(t, Ref{GoType: s.Name, Package: b.cfg.Client.ImportPath()}), nil
+	default:
+		return b.inputType(t)
+	}
+}
+
+// inputType is the go type for a scalar, enum or input type, generating the enum or input type if it is new
+func (b *clientBuilder) inputType(t *ast.Type) (*ClientType, error) {
+	def := b.cfg.schema.Types[t.Name()]
+	switch def.Kind {
+	case ast.Scalar:
+		goType := b.cfg.Client.Scalars[def.Name]
+		if goType == "" {
+			goType = clientScalars[def.Name]
+		}
+		if goType == "" {
+			goType = "encoding/json.RawMessage"
+		}
+		pkg, name := pkgAndType(goType)
+		return clientType(t, Ref{GoType: name, Package: pkg}), nil
+
+	case ast.Enum:
+		if err := b.enum(def); err != nil {
+			return nil, err
+		}
+	case ast.InputObject:
+		if err := b.input(def); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("%s is not an input type", def.Name)
+	}
+	return clientType(t, Ref{GoType: clientGoName(def.Name), Package: b.cfg.Client.ImportPath()}), nil
+}
+
+func (b *clientBuilder) enum(def *ast.Definition) error {
+	if b.enums[def.Name] {
+		return nil
+	}
+	b.enums[def.Name] = true
+
+	enum := &ClientEnum{Name: clientGoName(def.Name), Description: def.Description}
+	if err := b.claim(enum.Name, "enum "+def.Name); err != nil {
+		return err
+	}
+	for _, value := range def.EnumValues {
+		enum.Values = append(enum.Values, ClientEnumValue{
+			Name:        enum.Name + templates.ToCamel(value.Name),
+			Description: value.Description,
+			Value:       value.Name,
+		})
+	}
+	b.build.Enums = append(b.build.Enums, enum)
+	return nil
+}
+
+func (b *clientBuilder) input(def *ast.Definition) error {
+	if b.inputs[def.Name] {
+		return nil
+	}
+	b.inputs[def.Name] = true
+
+	input := &ClientStruct{Name: clientGoName(def.Name), Description: def.Description}
+	if err := b.claim(input.Name, "input "+def.Name); err != nil {
+		return err
+	}
+	b.build.Inputs = append(b.build.Inputs, input)
+
+	for _, field := range def.Fields {
+		goType, err := b.inputType(field.Type)
+		if err != nil {
+			return errors.Wrapf(err, "%s.%s", def.Name, field.Name)
+		}
+		tag := fmt.Sprintf(`json:"%s"`, field.Name)
+		if !field.Type.NonNull {
+			tag = fmt.Sprintf(`json:"%s,omitempty"`, field.Name)
+		}
+		input.Fields = append(input.Fields, &ClientField{
+			GoName:      clientGoName(field.Name),
+			Description: field.Description,
+			Type:        goType,
+			Tag:         tag,
+		})
+	}
+	return nil
+}
+
+// clientType adds the list and pointer modifiers to a go type, nullable values are pointers unless the go type can
+// already be nil
+func clientType(t *ast.Type, ref Ref) *ClientType {
+	goType := &ClientType{Ref: ref}
+	for t.Elem != nil {
+		goType.Modifiers = append(goType.Modifiers, modList)
+		t = t.Elem
+	}
+	nilable := strings.HasPrefix(ref.GoType, "map[") || strings.HasPrefix(ref.GoType, "[]") ||
+		ref.GoType == "interface{}" || ref.Package == "encoding/json" && ref.GoType == "RawMessage"
+	if !t.NonNull && !nilable {
+		goType.Modifiers = append(goType.Modifiers, modPtr)
+	}
+	return goType
+}
+
+func clientGoName(name string) string {
+	return lintName(ucFirst(strings.TrimLeft(name, "_")))
+}
+
+func clientVarName(name string) string {
+	for _, reserved := range clientReserved {
+		if name == reserved {
+			return name + "Arg"
+		}
+	}
+	return sanitizeArgName(name)
+}
+
+type clientPlugin struct{}
+
+func (clientPlugin) Name() string {
+	return "client"
+}
+
+func (clientPlugin) GenerateCode(cfg *Config, build *Build) error {
+	if !cfg.Client.IsDefined() {
+		return nil
+	}
+	return cfg.generateClient()
+}
@@ -0,0 +1,120 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+const clientTestSchema = `
+	type Query {
+		user(id: ID!): User
+		search(text: String!, first: Int = 10): [SearchResult!]!
+	}
+	type Mutation { createUser(input: NewUser!): User! }
+	type Subscription { userJoined: User! }
+	union SearchResult = User | Post
+	"A role a user can have"
+	enum Role { ADMIN, MEMBER }
+	type User { id: ID!, name: String!, role: Role!, joined: Time, location: Point, friends: [User] }
+	type Post { id: ID!, title: String! }
+	input NewUser { name: String!, role: Role, tags: [String!] }
+	scalar Time
+	scalar Point
+`
+
+func TestClient(t *testing.T) {
+	err := Generate(Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr:      map[string]string{"schema.graphql": clientTestSchema},
+		Exec:           PackageConfig{Filename: "gen/client/exec.go"},
+		Model:          ModelConfig{PackageConfig: PackageConfig{Filename: "gen/client/model.go"}},
+		Models:         TypeMap{"Point": {Model: "github.com/99designs/gqlgen/graphql.String"}},
+		Client: ClientConfig{
+			PackageConfig: PackageConfig{Filename: "gen/client/client/client.go"},
+			OperationStr: map[string]string{"queries.graphql": `
+				# the user and their friends
+				query GetUser($id: ID!) {
+					user(id: $id) { ...UserFields friends { ...UserFields } }
+				}
+				# searches users and posts
+				query Search($text: String!, $first: Int) {
+					results: search(text: $text, first: $first) {
+						__typename
+						... on User { id name }
+						... on Post { id title }
+					}
+				}
+				mutation CreateUser($input: NewUser!) { createUser(input: $input) { id } }
+				fragment UserFields on User { id name role joined location }
+			`},
+		},
+	})
+	require.NoError(t, err)
+
+	client, err := ioutil.ReadFile("gen/client/client/client.go")
+	require.NoError(t, err)
+	require.Contains(t, string(client), "func (c *Client) GetUser(ctx context.Context, id string) (*GetUserResponse, error) {")
+	require.Contains(t, string(client), "const GetUserDocument = `query GetUser($id: ID!) {\n"+
+		"\t\t\t\t\tuser(id: $id) { ...UserFields friends { ...UserFields } }\n"+
+		"\t\t\t\t}\n\n"+
+		"fragment UserFields on User { id name role joined location }`")
+	require.Contains(t, string(client), "func (c *Client) Search(ctx context.Context, text string, first *int) (*SearchResponse, error) {")
+	require.Contains(t, string(client), "\tif first != nil {\n\t\tvars[\"first\"] = first\n\t}")
+	require.Contains(t, string(client), "type GetUserResponse struct {\n\tUser *GetUserResponseUser `json:\"user\"`\n}")
+	require.Contains(t, string(client), "\tFriends  []*GetUserResponseUserFriends `json:\"friends\"`")
+	require.Contains(t, string(client), "\tLocation json.RawMessage               `json:\"location\"`")
+	require.Contains(t, string(client), "\tJoined   *time.Time                    `json:\"joined\"`")
+	require.Contains(t, string(client), "type SearchResponseResults struct {\n"+
+		"\tTypename string `json:\"__typename\"`\n"+
+		"\tID       string `json:\"id\"`\n"+
+		"\tName     string `json:\"name\"`\n"+
+		"\tTitle    string `json:\"title\"`\n}")
+	require.Contains(t, string(client), "type NewUser struct {\n"+
+		"\tName string   `json:\"name\"`\n"+
+		"\tRole *Role    `json:\"role,omitempty\"`\n"+
+		"\tTags []string `json:\"tags,omitempty\"`\n}")
+	require.Contains(t, string(client), "// A role a user can have\ntype Role string")
+	require.Contains(t, string(client), "\tRoleAdmin  Role = \"ADMIN\"")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/client/client")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+
+	generateClient := func(operations string) error {
+		return GenerateClient(Config{
+			SchemaFilename: SchemaFilenames{"schema.graphql"},
+			SchemaStr:      map[string]string{"schema.graphql": clientTestSchema},
+			Exec:           PackageConfig{Filename: "gen/client/exec.go"},
+			Model:          ModelConfig{PackageConfig: PackageConfig{Filename: "gen/client/model.go"}},
+			Client: ClientConfig{
+				PackageConfig: PackageConfig{Filename: "gen/client/client/client.go"},
+				OperationStr:  map[string]string{"queries.graphql": operations},
+			},
+		})
+	}
+
+	t.Run("operations are validated", func(t *testing.T) {
+		err := generateClient(`query GetUser { user(id: 1) { email } }`)
+		require.EqualError(t, err, "client build failed: queries.graphql:1: Cannot query field \"email\" on type \"User\".\n")
+	})
+
+	t.Run("operations must be named", func(t *testing.T) {
+		err := generateClient(`{ user(id: 1) { id } }`)
+		require.EqualError(t, err, "client build failed: operations sent by the client must be named")
+	})
+
+	t.Run("subscriptions are not supported", func(t *testing.T) {
+		err := generateClient(`subscription Joined { userJoined { id } }`)
+		require.EqualError(t, err, "client build failed: subscription Joined: subscriptions are not supported by the client")
+	})
+
+	t.Run("generated names must be unique", func(t *testing.T) {
+		err := generateClient(`
+			query Get { user(id: 1) { friends { id } } userFriends: user(id: 2) { id } }
+		`)
+		require.EqualError(t, err, "client build failed: Get.user.friends and Get.userFriends would both generate GetResponseUserFriends, rename one of them or use an alias")
+	})
+}
@@ -14,48 +14,78 @@ import (
 	"github.com/vektah/gqlparser/gqlerror"
 )
 
+// Generate runs the default plugins
 func Generate(cfg Config) error {
+	return GenerateWithPlugins(cfg, DefaultPlugins())
+}
+
+// GenerateWithPlugins generates code for the schema, calling each plugin hook in turn
+func GenerateWithPlugins(cfg Config, plugins []Plugin) error {
+	for _, p := range plugins {
+		if mut, ok := p.(ConfigMutator); ok {
+			if err := mut.MutateConfig(&cfg); err != nil {
+				return errors.Wrapf(err, "%s plugin failed", p.Name())
+			}
+		}
+	}
+
 	if err := cfg.normalize(); err != nil {
 		return err
 	}
 
-	_ = syscall.Unlink(cfg.Exec.Filename)
+	for _, p := range plugins {
+		if mut, ok := p.(SchemaMutator); ok {
+			if err := mut.MutateSchema(cfg.schema); err != nil {
+				return errors.Wrapf(err, "%s plugin failed", p.Name())
+			}
+		}
+	}
+
+	cfg.unlinkGeneratedExec()
 	_ = syscall.Unlink(cfg.Model.Filename)
+	_ = syscall.Unlink(cfg.loadersFilename())
+	if cfg.Mock.IsDefined() {
+		_ = syscall.Unlink(cfg.Mock.Filename)
+	}
+
+	if err := cfg.autobind(); err != nil {
+		return errors.Wrap(err, "autobind failed")
+	}
 
 	modelsBuild, err := cfg.models()
 	if err != nil {
 		return errors.Wrap(err, "model plan failed")
 	}
-	if len(modelsBuild.Models) > 0 || len(modelsBuild.Enums) > 0 {
-		if err = templates.RenderToFile("models.gotpl", cfg.Model.Filename, modelsBuild); err != nil {
-			return err
-		}
 
-		for _, model := range modelsBuild.Models {
-			modelCfg := cfg.Models[model.GQLType]
-			modelCfg.Model = cfg.Model.ImportPath() + "." + model.GoType
-			cfg.Models[model.GQLType] = modelCfg
+	for _, p := range plugins {
+		if mut, ok := p.(ModelMutator); ok {
+			if err := mut.MutateModels(modelsBuild); err != nil {
+				return errors.Wrapf(err, "%s plugin failed", p.Name())
+			}
 		}
+	}
 
-		for _, enum := range modelsBuild.Enums {
-			modelCfg := cfg.Models[enum.GQLType]
-			modelCfg.Model = cfg.Model.ImportPath() + "." + enum.GoType
-			cfg.Models[enum.GQLType] = modelCfg
+	for _, p := range plugins {
+		if gen, ok := p.(ModelGenerator); ok {
+			if err := gen.GenerateModels(&cfg, modelsBuild); err != nil {
+				return errors.Wrapf(err, "generating %s failed", p.Name())
+			}
 		}
 	}
 
+	// the models have been written, the packages are loaded again when binding
+	cfg.pkgs.evict()
+
 	build, err := cfg.bind()
 	if err != nil {
 		return errors.Wrap(err, "exec plan failed")
 	}
 
-	if err := templates.RenderToFile("generated.gotpl", cfg.Exec.Filename, build); err != nil {
-		return err
-	}
-
-	if cfg.Resolver.IsDefined() {
-		if err := generateResolver(cfg); err != nil {
-			return errors.Wrap(err, "generating resolver failed")
+	for _, p := range plugins {
+		if gen, ok := p.(CodeGenerator); ok {
+			if err := gen.GenerateCode(&cfg, build); err != nil {
+				return errors.Wrapf(err, "generating %s failed", p.Name())
+			}
 		}
 	}
 
@@ -67,6 +97,8 @@ func Generate(cfg Config) error {
 }
 
 func GenerateServer(cfg Config, filename string) error {
+	cfg.Exec.layoutDefaults(defaultExecFilename, defaultExecFilenameTemplate)
+	cfg.Resolver.layoutDefaults(defaultResolverFilename, defaultFilenameTemplate)
 	if err := cfg.Exec.normalize(); err != nil {
 		return errors.Wrap(err, "exec")
 	}
@@ -88,27 +120,8 @@ func GenerateServer(cfg Config, filename string) error {
 	return nil
 }
 
-func generateResolver(cfg Config) error {
-	resolverBuild, err := cfg.resolver()
-	if err != nil {
-		return errors.Wrap(err, "resolver build failed")
-	}
-	filename := cfg.Resolver.Filename
-
-	if resolverBuild.ResolverFound {
-		log.Printf("Skipped resolver: %s.%s already exists\n", cfg.Resolver.ImportPath(), cfg.Resolver.Type)
-		return nil
-	}
-
-	if _, err := os.Stat(filename); os.IsNotExist(errors.Cause(err)) {
-		if err := templates.RenderToFile("resolver.gotpl", filename, resolverBuild); err != nil {
-			return err
-		}
-	} else {
-		log.Printf("Skipped resolver: %s already exists\n", filename)
-	}
-
-	return nil
+func (cfg *Config) loadersFilename() string {
+	return filepath.Join(cfg.Exec.Dir(), "loaders_gen.go")
 }
 
 func (cfg *Config) normalize() error {
@@ -116,16 +129,36 @@ func (cfg *Config) normalize() error {
 		return errors.Wrap(err, "model")
 	}
 
+	cfg.Exec.layoutDefaults(defaultExecFilename, defaultExecFilenameTemplate)
 	if err := cfg.Exec.normalize(); err != nil {
 		return errors.Wrap(err, "exec")
 	}
 
+	cfg.Resolver.layoutDefaults(defaultResolverFilename, defaultFilenameTemplate)
 	if cfg.Resolver.IsDefined() {
 		if err := cfg.Resolver.normalize(); err != nil {
 			return errors.Wrap(err, "resolver")
 		}
 	}
 
+	if cfg.Mock.IsDefined() {
+		if cfg.Mock.Type == "" {
+			cfg.Mock.Type = "MockResolver"
+		}
+		if err := cfg.Mock.normalize(); err != nil {
+			return errors.Wrap(err, "mock")
+		}
+	}
+
+	if cfg.Client.IsDefined() {
+		if cfg.Client.Type == "" {
+			cfg.Client.Type = "Client"
+		}
+		if err := cfg.Client.normalize(); err != nil {
+			return errors.Wrap(err, "client")
+		}
+	}
+
 	builtins := TypeMap{
 		"__Directive":  {Model: "github.com/99designs/gqlgen/graphql/introspection.Directive"},
 		"__Type":       {Model: "github.com/99designs/gqlgen/graphql/introspection.Type"},
@@ -151,17 +184,43 @@ func (cfg *Config) normalize() error {
 		}
 	}
 
+	if cfg.Relay {
+		if err := cfg.relaySchema(); err != nil {
+			return errors.Wrap(err, "relay")
+		}
+	}
+	if cfg.Federation {
+		if err := cfg.federationSchema(); err != nil {
+			return errors.Wrap(err, "federation")
+		}
+	}
+
 	var sources []*ast.Source
 	for _, filename := range cfg.SchemaFilename {
 		sources = append(sources, &ast.Source{Name: filename, Input: cfg.SchemaStr[filename]})
 	}
+	if relay, ok := cfg.SchemaStr[relayFilename]; ok {
+		sources = append(sources, &ast.Source{Name: relayFilename, Input: relay})
+	}
+	if federation, ok := cfg.SchemaStr[federationFilename]; ok {
+		sources = append(sources, &ast.Source{Name: federationFilename, Input: federation})
+	}
 
-	var err *gqlerror.Error
-	cfg.schema, err = gqlparser.LoadSchema(sources...)
+	directives, err := codegenDirectivesSource(sources)
 	if err != nil {
 		return err
 	}
-	return nil
+	if directives != nil {
+		sources = append(sources, directives)
+	}
+
+	var schemaErr *gqlerror.Error
+	cfg.schema, schemaErr = gqlparser.LoadSchema(sources...)
+	if schemaErr != nil {
+		return schemaErr
+	}
+
+	return cfg.mergeCodegenDirectives()
 }
 
 var invalidPackageNameChar = regexp.MustCompile(`[^\w]`)
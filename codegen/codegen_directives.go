@@ -0,0 +1,211 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/lexer"
+	"github.com/vektah/gqlparser/parser"
+)
+
+// codegenDirectives configure the generated code. They are declared automatically when the schema doesn't declare
+// them itself, are never executed through the DirectiveRoot and are stripped from the schema served by introspection.
+var codegenDirectives = map[string]string{
+	"constraint": `directive @constraint(minLength: Int, maxLength: Int, min: Float, max: Float, pattern: String, format: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION`,
+	"goModel":    `directive @goModel(model: String) on OBJECT | INPUT_OBJECT | SCALAR | ENUM | INTERFACE | UNION`,
+	"goField":    `directive @goField(name: String, forceResolver: Boolean) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION`,
+	"goTag":      `directive @goTag(key: String!, value: String) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION`,
+	"mock":       `directive @mock(value: String!) on FIELD_DEFINITION`,
+}
+
+var codegenDirectiveNames = []string{"constraint", "goField", "goModel", "goTag", "mock"}
+
+// codegenDirectivesSource declares any of the codegen directives that are missing from the schema
+func codegenDirectivesSource(sources []*ast.Source) (*ast.Source, error) {
+	doc, err := parser.ParseSchemas(sources...)
+	if err != nil {
+		return nil, err
+	}
+
+	var declarations []string
+	for _, name := range codegenDirectiveNames {
+		if doc.Directives.ForName(name) == nil {
+			declarations = append(declarations, codegenDirectives[name])
+		}
+	}
+	if len(declarations) == 0 {
+		return nil, nil
+	}
+
+	return &ast.Source{Name: "gqlgen_directives.graphql", Input: strings.Join(declarations, "\n")}, nil
+}
+
+// mergeCodegenDirectives copies @goModel and @goField into the TypeMap, anything set in gqlgen.yml takes precedence
+func (cfg *Config) mergeCodegenDirectives() error {
+	for _, def := range cfg.schema.Types {
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+
+		entry := cfg.Models[def.Name]
+		changed := false
+
+		if dir := def.Directives.ForName("goModel"); dir != nil {
+			model, err := directiveArg(dir, "model")
+			if err != nil {
+				return err
+			}
+			if model, _ := model.(string); model != "" && entry.Model == "" {
+				if strings.LastIndex(model, ".") < strings.LastIndex(model, "/") {
+					return fmt.Errorf("@goModel on %s: invalid type specifier \"%s\" - you need to specify a struct to map to", def.Name, model)
+				}
+				entry.Model = model
+				changed = true
+			}
+		}
+
+		for _, field := range def.Fields {
+			dir := field.Directives.ForName("goField")
+			if dir == nil {
+				continue
+			}
+
+			name, err := directiveArg(dir, "name")
+			if err != nil {
+				return err
+			}
+			forceResolver, err := directiveArg(dir, "forceResolver")
+			if err != nil {
+				return err
+			}
+
+			if entry.Fields == nil {
+				entry.Fields = map[string]TypeMapField{}
+			}
+			fieldEntry := entry.Fields[field.Name]
+			if name, _ := name.(string); name != "" && fieldEntry.FieldName == "" {
+				fieldEntry.FieldName = name
+			}
+			if forceResolver, _ := forceResolver.(bool); forceResolver {
+				fieldEntry.Resolver = true
+			}
+			entry.Fields[field.Name] = fieldEntry
+			changed = true
+		}
+
+		if changed {
+			cfg.Models[def.Name] = entry
+		}
+	}
+	return nil
+}
+
+func directiveArg(dir *ast.Directive, name string) (interface{}, error) {
+	arg := dir.Arguments.ForName(name)
+	if arg == nil {
+		return nil, nil
+	}
+	val, err := arg.Value.Value(nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "@%s(%s)", dir.Name, name)
+	}
+	return val, nil
+}
+
+// stripCodegenDirectives removes any uses or declarations of the codegen directives from a schema source
+func stripCodegenDirectives(input string) (string, error) {
+	var tokens []lexer.Token
+	lex := lexer.New(&ast.Source{Input: input})
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return "", err
+		}
+		if tok.Kind == lexer.EOF {
+			break
+		}
+		tokens = append(tokens, tok)
+	}
+
+	isCodegenDirective := func(i int) bool {
+		if i+1 >= len(tokens) || tokens[i].Kind != lexer.At || tokens[i+1].Kind != lexer.Name {
+			return false
+		}
+		_, ok := codegenDirectives[tokens[i+1].Value]
+		return ok
+	}
+
+	// skipArgs returns the index after a parenthesised argument list starting at i, if there is one
+	skipArgs := func(i int) int {
+		if i >= len(tokens) || tokens[i].Kind != lexer.ParenL {
+			return i
+		}
+		depth := 0
+		for ; i < len(tokens); i++ {
+			switch tokens[i].Kind {
+			case lexer.ParenL:
+				depth++
+			case lexer.ParenR:
+				depth--
+				if depth == 0 {
+					return i + 1
+				}
+			}
+		}
+		return i
+	}
+
+	runes := []rune(input)
+	var out []rune
+	last := 0
+	remove := func(start, end int) {
+		for start > last && (runes[start-1] == ' ' || runes[start-1] == '\t') {
+			start--
+		}
+		out = append(out, runes[last:start]...)
+		last = end
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		switch {
+		case tok.Kind == lexer.Name && tok.Value == "directive" && isCodegenDirective(i+1):
+			start := tok.Pos.Start
+			if i > 0 && (tokens[i-1].Kind == lexer.String || tokens[i-1].Kind == lexer.BlockString) {
+				start = tokens[i-1].Pos.Start
+			}
+
+			j := skipArgs(i + 3)
+			if j < len(tokens) && tokens[j].Kind == lexer.Name && tokens[j].Value == "on" {
+				j++
+			}
+			for j < len(tokens) && (tokens[j].Kind == lexer.Pipe || tokens[j].Kind == lexer.Name && isDirectiveLocation(tokens[j].Value)) {
+				j++
+			}
+
+			remove(start, tokens[j-1].Pos.End)
+			i = j - 1
+
+		case isCodegenDirective(i):
+			j := skipArgs(i + 2)
+			remove(tok.Pos.Start, tokens[j-1].Pos.End)
+			i = j - 1
+		}
+	}
+
+	return string(append(out, runes[last:]...)), nil
+}
+
+func isDirectiveLocation(name string) bool {
+	switch ast.DirectiveLocation(name) {
+	case ast.LocationQuery, ast.LocationMutation, ast.LocationSubscription, ast.LocationField,
+		ast.LocationFragmentDefinition, ast.LocationFragmentSpread, ast.LocationInlineFragment,
+		ast.LocationSchema, ast.LocationScalar, ast.LocationObject, ast.LocationFieldDefinition,
+		ast.LocationArgumentDefinition, ast.LocationInterface, ast.LocationUnion, ast.LocationEnum,
+		ast.LocationEnumValue, ast.LocationInputObject, ast.LocationInputFieldDefinition:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestStripCodegenDirectives(t *testing.T) {
+	stripped, err := stripCodegenDirectives(`
+		"binds models"
+		directive @goModel(model: String) on OBJECT | INPUT_OBJECT
+		directive @auth(role: String) on FIELD_DEFINITION
+
+		# a comment with @goModel(model: "x")
+		type User @goModel(model: "github.com/my/app.User") @key {
+			id: ID! @goField(name: "UserID") @auth(role: "admin")
+			name: String @goTag(key: "db")
+		}`)
+	require.NoError(t, err)
+	require.Equal(t, `
+
+		directive @auth(role: String) on FIELD_DEFINITION
+
+		# a comment with @goModel(model: "x")
+		type User @key {
+			id: ID! @auth(role: "admin")
+			name: String
+		}`, stripped)
+}
+
+func TestGoModelAndGoField(t *testing.T) {
+	cfg := Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			type Query { user: User }
+			type User @goModel(model: "github.com/99designs/gqlgen/codegen/testdata/autobinding.User") {
+				id: Int!
+				fullName: String! @goField(name: "Name")
+				friends: [User!]! @goField(forceResolver: true)
+			}
+		`},
+		Exec:  PackageConfig{Filename: "gen/godirectives/exec.go"},
+		Model: ModelConfig{PackageConfig: PackageConfig{Filename: "gen/godirectives/model.go"}},
+	}
+
+	err := Generate(cfg)
+	require.NoError(t, err)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/godirectives")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+
+	userResolver := pkgs[0].Types.Scope().Lookup("UserResolver")
+	require.NotNil(t, userResolver)
+	require.Contains(t, userResolver.Type().Underlying().String(), "Friends(")
+	require.NotContains(t, userResolver.Type().Underlying().String(), "FullName(")
+
+	exec, err := ioutil.ReadFile("gen/godirectives/exec.go")
+	require.NoError(t, err)
+	require.NotContains(t, string(exec), "@goModel")
+	require.NotContains(t, string(exec), "@goField")
+}
@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
 func TestGenerateServer(t *testing.T) {
@@ -24,7 +24,7 @@ func TestGenerateServer(t *testing.T) {
 		SchemaFilename: SchemaFilenames{"schema.graphql"},
 		SchemaStr:      map[string]string{"schema.graphql": schema},
 		Exec:           PackageConfig{Filename: "gen/" + name + "/exec.go"},
-		Model:          PackageConfig{Filename: "gen/" + name + "/model.go"},
+		Model:          ModelConfig{PackageConfig: PackageConfig{Filename: "gen/" + name + "/model.go"}},
 		Resolver:       PackageConfig{Filename: "gen/" + name + "/resolver.go", Type: "Resolver"},
 	}
 
@@ -37,9 +37,7 @@ func TestGenerateServer(t *testing.T) {
 	err = GenerateServer(cfg, serverFilename)
 	require.NoError(t, err)
 
-	conf := loader.Config{}
-	conf.CreateFromFilenames("gen/"+name, serverFilename)
-
-	_, err = conf.Load()
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/"+name+"/server")
 	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
 }
@@ -2,12 +2,12 @@ package codegen
 
 import (
 	"fmt"
-	"go/build"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/internal/gopath"
 	"github.com/pkg/errors"
@@ -22,7 +22,7 @@ func DefaultConfig() *Config {
 	return &Config{
 		SchemaFilename: SchemaFilenames{"schema.graphql"},
 		SchemaStr:      map[string]string{},
-		Model:          PackageConfig{Filename: "models_gen.go"},
+		Model:          ModelConfig{PackageConfig: PackageConfig{Filename: "models_gen.go"}},
 		Exec:           PackageConfig{Filename: "generated.go"},
 	}
 }
@@ -55,20 +55,13 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, errors.Wrap(err, "unable to parse config")
 	}
 
-	preGlobbing := config.SchemaFilename
-	config.SchemaFilename = SchemaFilenames{}
-	for _, f := range preGlobbing {
-		matches, err := filepath.Glob(f)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to glob schema filename %s", f)
-		}
-
-		for _, m := range matches {
-			if config.SchemaFilename.Has(m) {
-				continue
-			}
-			config.SchemaFilename = append(config.SchemaFilename, m)
-		}
+	config.SchemaFilename, err = config.SchemaFilename.glob()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to glob schema filename")
+	}
+	config.Client.Operations, err = config.Client.Operations.glob()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to glob client operations")
 	}
 
 	config.FilePath = filename
@@ -81,25 +74,79 @@ type Config struct {
 	SchemaFilename SchemaFilenames   `yaml:"schema,omitempty"`
 	SchemaStr      map[string]string `yaml:"-"`
 	Exec           PackageConfig     `yaml:"exec"`
-	Model          PackageConfig     `yaml:"model"`
+	Model          ModelConfig       `yaml:"model"`
 	Resolver       PackageConfig     `yaml:"resolver,omitempty"`
 	Models         TypeMap           `yaml:"models,omitempty"`
+	AutoBind       []string          `yaml:"autobind,omitempty"`
+	Loaders        LoaderMap         `yaml:"loaders,omitempty"`
 	StructTag      string            `yaml:"struct_tag,omitempty"`
+	TypedArgs      bool              `yaml:"typed_args,omitempty"`
+	InputIsSet     bool              `yaml:"input_is_set,omitempty"`
+	Relay          bool              `yaml:"relay,omitempty"`
+	Federation     bool              `yaml:"federation,omitempty"`
+	Mock           MockConfig        `yaml:"mock,omitempty"`
+	Client         ClientConfig      `yaml:"client,omitempty"`
 
 	FilePath string `yaml:"-"`
 
 	schema *ast.Schema `yaml:"-"`
+	pkgs   *pkgLoader  `yaml:"-"`
 }
 
 type PackageConfig struct {
 	Filename string `yaml:"filename,omitempty"`
 	Package  string `yaml:"package,omitempty"`
 	Type     string `yaml:"type,omitempty"`
+	Layout   string `yaml:"layout,omitempty"`
+	// Used by the follow-schema layout, the directory to write to and the name of the file written for each schema
+	// file, where {name} is replaced by the schema filename without its extension
+	DirName          string `yaml:"dir,omitempty"`
+	FilenameTemplate string `yaml:"filenameTemplate,omitempty"`
+}
+
+// ModelConfig is where generated models are written, along with how their fields are declared
+type ModelConfig struct {
+	PackageConfig `yaml:",inline"`
+
+	// Tags added to every generated field, json is always included
+	Tags []ModelTag `yaml:"tags,omitempty"`
+	// Whether nullable scalar and enum fields are generated as a pointer (the default) or a value
+	NullableScalars string `yaml:"nullableScalars,omitempty"`
+}
+
+type MockConfig struct {
+	PackageConfig `yaml:",inline"`
+
+	// The number of items in each list, unless the field is listed in Lists. Defaults to 3.
+	ListSize int `yaml:"listSize,omitempty"`
+	// The number of items in a list field, keyed by Type.field
+	Lists map[string]int `yaml:"lists,omitempty"`
+}
+
+type ClientConfig struct {
+	PackageConfig `yaml:",inline"`
+
+	// The .graphql files with the operations the client sends, globs are supported
+	Operations   SchemaFilenames   `yaml:"operations,omitempty"`
+	OperationStr map[string]string `yaml:"-"`
+	// The go type for each custom scalar, it is decoded from json. Defaults to json.RawMessage.
+	Scalars map[string]string `yaml:"scalars,omitempty"`
+}
+
+type ModelTag struct {
+	Key string `yaml:"key"`
+	// How the tag value is derived from the field, one of graphql (the default), go, snake or camel
+	Name      string `yaml:"name,omitempty"`
+	OmitEmpty bool   `yaml:"omitempty,omitempty"`
 }
 
 type TypeMapEntry struct {
 	Model  string                  `yaml:"model"`
 	Fields map[string]TypeMapField `yaml:"fields,omitempty"`
+	// For input types, record which fields were given in an IsSet map[string]bool field on the model
+	IsSet bool `yaml:"isSet,omitempty"`
+	// For enums bound to a go type, the go constant for each graphql value, eg github.com/my/app/models.EpisodeJedi
+	EnumValues map[string]string `yaml:"enumValues,omitempty"`
 }
 
 type TypeMapField struct {
@@ -107,6 +154,14 @@ type TypeMapField struct {
 	FieldName string `yaml:"fieldName"`
 }
 
+type LoaderConfig struct {
+	Type     string `yaml:"type,omitempty"`
+	Key      string `yaml:"key"`
+	Slice    bool   `yaml:"slice,omitempty"`
+	Wait     string `yaml:"wait,omitempty"`
+	MaxBatch int    `yaml:"maxBatch,omitempty"`
+}
+
 type SchemaFilenames []string
 
 func (a *SchemaFilenames) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -127,6 +182,25 @@ func (a *SchemaFilenames) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return nil
 }
 
+// glob expands any patterns in the filenames, eg queries/*.graphql
+func (a SchemaFilenames) glob() (SchemaFilenames, error) {
+	globbed := SchemaFilenames{}
+	for _, f := range a {
+		matches, err := filepath.Glob(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", f)
+		}
+
+		for _, m := range matches {
+			if globbed.Has(m) {
+				continue
+			}
+			globbed = append(globbed, m)
+		}
+	}
+	return globbed, nil
+}
+
 func (a SchemaFilenames) Has(file string) bool {
 	for _, existing := range a {
 		if existing == file {
@@ -136,7 +210,29 @@ func (a SchemaFilenames) Has(file string) bool {
 	return false
 }
 
+// layoutDefaults fills in the follow-schema options, setting a dir on its own is enough to use the layout
+func (c *PackageConfig) layoutDefaults(filename string, filenameTemplate string) {
+	if c.Layout == "" && c.DirName != "" {
+		c.Layout = LayoutFollowSchema
+	}
+	if c.Layout != LayoutFollowSchema {
+		return
+	}
+	if c.Filename == "" && c.DirName != "" {
+		c.Filename = filepath.Join(c.DirName, filename)
+	}
+	if c.DirName == "" && c.Filename != "" {
+		c.DirName = filepath.Dir(c.Filename)
+	}
+	if c.FilenameTemplate == "" {
+		c.FilenameTemplate = filenameTemplate
+	}
+}
+
 func (c *PackageConfig) normalize() error {
+	if c.DirName != "" {
+		c.DirName = abs(c.DirName)
+	}
 	if c.Filename == "" {
 		return errors.New("Filename is required")
 	}
@@ -145,7 +241,7 @@ func (c *PackageConfig) normalize() error {
 	// fallback to just the base dir name of the output filename.
 	if c.Package == "" {
 		cwd, _ := os.Getwd()
-		pkg, _ := build.Default.Import(c.ImportPath(), cwd, 0)
+		pkg, _ := gopath.Import(c.ImportPath(), cwd, 0)
 		if pkg.Name != "" {
 			c.Package = pkg.Name
 		} else {
@@ -164,6 +260,34 @@ func (c *PackageConfig) Dir() string {
 	return filepath.Dir(c.Filename)
 }
 
+// checkLayout validates the layout options against the layouts a package supports
+func (c *PackageConfig) checkLayout(layouts ...string) error {
+	layout := c.Layout
+	if layout == "" && c.DirName != "" {
+		layout = LayoutFollowSchema
+	}
+
+	supported := layout == ""
+	for _, l := range layouts {
+		supported = supported || l == layout
+	}
+	if !supported {
+		if len(layouts) == 0 {
+			return fmt.Errorf("layout is not supported")
+		}
+		return fmt.Errorf("layout must be one of %s", strings.Join(layouts, ", "))
+	}
+
+	if layout != LayoutFollowSchema && (c.DirName != "" || c.FilenameTemplate != "") {
+		return fmt.Errorf("dir and filenameTemplate are only supported by the %s layout", LayoutFollowSchema)
+	}
+	if c.FilenameTemplate != "" && (!strings.Contains(c.FilenameTemplate, "{name}") ||
+		!strings.HasSuffix(c.FilenameTemplate, ".go") || strings.ContainsAny(c.FilenameTemplate, "/\\")) {
+		return fmt.Errorf("filenameTemplate must be a go filename containing {name}")
+	}
+	return nil
+}
+
 func (c *PackageConfig) Check() error {
 	if strings.ContainsAny(c.Package, "./\\") {
 		return fmt.Errorf("package should be the output package name only, do not include the output filename")
@@ -175,7 +299,93 @@ func (c *PackageConfig) Check() error {
 }
 
 func (c *PackageConfig) IsDefined() bool {
-	return c.Filename != ""
+	return c.Filename != "" || c.DirName != ""
+}
+
+// followSchemaFilename is the file the follow-schema layout writes for a schema file
+func (c *PackageConfig) followSchemaFilename(schemaFile string) string {
+	name := strings.TrimSuffix(filepath.Base(schemaFile), filepath.Ext(schemaFile))
+	return filepath.Join(c.DirName, strings.Replace(c.FilenameTemplate, "{name}", name, -1))
+}
+
+func (c *ModelConfig) Check() error {
+	if err := c.PackageConfig.Check(); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, tag := range c.Tags {
+		if tag.Key == "" || strings.ContainsAny(tag.Key, " :\"`") {
+			return fmt.Errorf("tags: invalid key \"%s\"", tag.Key)
+		}
+		if seen[tag.Key] {
+			return fmt.Errorf("tags: %s is listed more than once", tag.Key)
+		}
+		seen[tag.Key] = true
+
+		switch tag.Name {
+		case "", tagNameGraphql, tagNameGo, tagNameSnake, tagNameCamel:
+		default:
+			return fmt.Errorf("tags: %s has an invalid name \"%s\", expected one of graphql, go, snake or camel", tag.Key, tag.Name)
+		}
+	}
+
+	switch c.NullableScalars {
+	case "", "pointer", "value":
+	default:
+		return fmt.Errorf("nullableScalars must be either pointer or value")
+	}
+	return nil
+}
+
+func (c *MockConfig) Check() error {
+	if err := c.PackageConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.checkLayout(); err != nil {
+		return err
+	}
+	if c.ListSize < 0 {
+		return fmt.Errorf("listSize must not be negative")
+	}
+	for field, size := range c.Lists {
+		if parts := strings.Split(field, "."); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("lists: %s should be Type.field", field)
+		}
+		if size < 0 {
+			return fmt.Errorf("lists: %s must not be negative", field)
+		}
+	}
+	return nil
+}
+
+// listSize is the number of items in a list field
+func (c *MockConfig) listSize(typeName string, fieldName string) int {
+	if size, ok := c.Lists[typeName+"."+fieldName]; ok {
+		return size
+	}
+	if c.ListSize > 0 {
+		return c.ListSize
+	}
+	return 3
+}
+
+func (c *ClientConfig) Check() error {
+	if err := c.PackageConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.checkLayout(); err != nil {
+		return err
+	}
+	if c.IsDefined() && len(c.Operations) == 0 && len(c.OperationStr) == 0 {
+		return fmt.Errorf("operations are required")
+	}
+	for scalar, goType := range c.Scalars {
+		if pkg, name := pkgAndType(goType); name == "" || strings.Contains(pkg, " ") {
+			return fmt.Errorf("scalars: %s has an invalid go type \"%s\"", scalar, goType)
+		}
+	}
+	return nil
 }
 
 func (cfg *Config) Check() error {
@@ -191,6 +401,29 @@ func (cfg *Config) Check() error {
 	if err := cfg.Resolver.Check(); err != nil {
 		return errors.Wrap(err, "config.resolver")
 	}
+	if err := cfg.Exec.checkLayout(LayoutFollowSchema); err != nil {
+		return errors.Wrap(err, "config.exec")
+	}
+	if err := cfg.Model.checkLayout(); err != nil {
+		return errors.Wrap(err, "config.model")
+	}
+	if err := cfg.Resolver.checkLayout(LayoutSingleFile, LayoutFollowSchema); err != nil {
+		return errors.Wrap(err, "config.resolver")
+	}
+	if err := cfg.Loaders.Check(); err != nil {
+		return errors.Wrap(err, "config.loaders")
+	}
+	if err := cfg.Mock.Check(); err != nil {
+		return errors.Wrap(err, "config.mock")
+	}
+	if err := cfg.Client.Check(); err != nil {
+		return errors.Wrap(err, "config.client")
+	}
+	for _, importPath := range cfg.AutoBind {
+		if importPath == "" || strings.ContainsAny(importPath, "\\ ") {
+			return fmt.Errorf("config.autobind: invalid package \"%s\"", importPath)
+		}
+	}
 	return nil
 }
 
@@ -206,6 +439,14 @@ func (tm TypeMap) Check() error {
 		if strings.LastIndex(entry.Model, ".") < strings.LastIndex(entry.Model, "/") {
 			return fmt.Errorf("model %s: invalid type specifier \"%s\" - you need to specify a struct to map to", typeName, entry.Model)
 		}
+		if len(entry.EnumValues) > 0 && entry.Model == "" {
+			return fmt.Errorf("model %s: enumValues needs a model to bind to", typeName)
+		}
+		for value, constant := range entry.EnumValues {
+			if pkg, name := pkgAndType(constant); pkg == "" || name == "" {
+				return fmt.Errorf("model %s: enum value %s should be a fully qualified go constant, not \"%s\"", typeName, value, constant)
+			}
+		}
 	}
 	return nil
 }
@@ -213,15 +454,19 @@ func (tm TypeMap) Check() error {
 func (tm TypeMap) referencedPackages() []string {
 	var pkgs []string
 
+	add := func(name string) {
+		pkg, _ := pkgAndType(name)
+		if pkg != "" && !inStrSlice(pkgs, pkg) {
+			pkgs = append(pkgs, pkg)
+		}
+	}
 	for _, typ := range tm {
-		if typ.Model == "map[string]interface{}" {
-			continue
+		if typ.Model != "map[string]interface{}" {
+			add(typ.Model)
 		}
-		pkg, _ := pkgAndType(typ.Model)
-		if pkg == "" || inStrSlice(pkgs, pkg) {
-			continue
+		for _, constant := range typ.EnumValues {
+			add(constant)
 		}
-		pkgs = append(pkgs, pkg)
 	}
 
 	sort.Slice(pkgs, func(i, j int) bool {
@@ -230,6 +475,28 @@ func (tm TypeMap) referencedPackages() []string {
 	return pkgs
 }
 
+type LoaderMap map[string]LoaderConfig
+
+func (lm LoaderMap) Check() error {
+	for name, loader := range lm {
+		if loader.Key == "" {
+			return fmt.Errorf("loader %s: key is required", name)
+		}
+		if strings.LastIndex(loader.Key, ".") < strings.LastIndex(loader.Key, "/") {
+			return fmt.Errorf("loader %s: invalid key type \"%s\"", name, loader.Key)
+		}
+		if loader.MaxBatch < 0 {
+			return fmt.Errorf("loader %s: maxBatch must not be negative", name)
+		}
+		if loader.Wait != "" {
+			if _, err := time.ParseDuration(loader.Wait); err != nil {
+				return errors.Wrapf(err, "loader %s: invalid wait", name)
+			}
+		}
+	}
+	return nil
+}
+
 func inStrSlice(haystack []string, needle string) bool {
 	for _, v := range haystack {
 		if needle == v {
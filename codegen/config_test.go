@@ -71,11 +71,74 @@ func TestReferencedPackages(t *testing.T) {
 					"field": {Resolver: false},
 				},
 			},
+			"Enum": {
+				Model:      "github.com/test.Enum",
+				EnumValues: map[string]string{"A": "github.com/consts.EnumA"},
+			},
 		}
 
 		pkgs := tm.referencedPackages()
 
-		assert.Equal(t, []string{"github.com/test", "github.com/otherpkg"}, pkgs)
+		assert.Equal(t, []string{"github.com/test", "github.com/otherpkg", "github.com/consts"}, pkgs)
+	})
+
+}
+
+func TestLoaderMapCheck(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		lm := LoaderMap{
+			"User":          {Key: "int"},
+			"PostsByAuthor": {Type: "Post", Key: "github.com/test.UserID", Slice: true, Wait: "2ms", MaxBatch: 100},
+		}
+		require.NoError(t, lm.Check())
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		lm := LoaderMap{"User": {}}
+		require.EqualError(t, lm.Check(), "loader User: key is required")
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		lm := LoaderMap{"User": {Key: "github.com/test"}}
+		require.EqualError(t, lm.Check(), `loader User: invalid key type "github.com/test"`)
+	})
+
+	t.Run("invalid wait", func(t *testing.T) {
+		lm := LoaderMap{"User": {Key: "int", Wait: "soon"}}
+		require.EqualError(t, lm.Check(), `loader User: invalid wait: time: invalid duration "soon"`)
 	})
+}
+
+func TestModelConfigCheck(t *testing.T) {
+	valid := ModelConfig{
+		Tags:            []ModelTag{{Key: "db", Name: "snake"}, {Key: "json", OmitEmpty: true}},
+		NullableScalars: "value",
+	}
+	require.NoError(t, valid.Check())
+
+	require.EqualError(t, (&ModelConfig{Tags: []ModelTag{{Key: ""}}}).Check(), `tags: invalid key ""`)
+	require.EqualError(t, (&ModelConfig{Tags: []ModelTag{{Key: "db"}, {Key: "db"}}}).Check(), "tags: db is listed more than once")
+	require.EqualError(t, (&ModelConfig{Tags: []ModelTag{{Key: "db", Name: "kebab"}}}).Check(), `tags: db has an invalid name "kebab", expected one of graphql, go, snake or camel`)
+	require.EqualError(t, (&ModelConfig{NullableScalars: "maybe"}).Check(), "nullableScalars must be either pointer or value")
+}
+
+func TestMockConfigCheck(t *testing.T) {
+	valid := MockConfig{ListSize: 5, Lists: map[string]int{"Query.users": 0}}
+	require.NoError(t, valid.Check())
+
+	require.EqualError(t, (&MockConfig{ListSize: -1}).Check(), "listSize must not be negative")
+	require.EqualError(t, (&MockConfig{Lists: map[string]int{"users": 2}}).Check(), "lists: users should be Type.field")
+	require.EqualError(t, (&MockConfig{Lists: map[string]int{"Query.users": -2}}).Check(), "lists: Query.users must not be negative")
+}
+
+func TestClientConfigCheck(t *testing.T) {
+	valid := ClientConfig{
+		PackageConfig: PackageConfig{Filename: "client/client_gen.go"},
+		Operations:    SchemaFilenames{"queries/user.graphql"},
+		Scalars:       map[string]string{"Point": "github.com/my/app/geo.Point"},
+	}
+	require.NoError(t, valid.Check())
 
+	require.EqualError(t, (&ClientConfig{PackageConfig: PackageConfig{Filename: "client.go"}}).Check(), "operations are required")
+	require.EqualError(t, (&ClientConfig{Scalars: map[string]string{"Point": "geo."}}).Check(), `scalars: Point has an invalid go type "geo."`)
 }
@@ -0,0 +1,75 @@
+package codegen
+
+import (
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// Constraint is a @constraint directive on an argument or input field, it is parsed into a package level var in
+// the generated code and checked once the value has been unmarshaled
+type Constraint struct {
+	VarName string                 // The name of the generated var
+	Args    map[string]interface{} // The directive arguments that were given
+}
+
+func (cfg *Config) buildConstraint(directives ast.DirectiveList, typ *Type, varName string) (*Constraint, error) {
+	dir := directives.ForName("constraint")
+	if dir == nil {
+		return nil, nil
+	}
+
+	args := map[string]interface{}{}
+	for _, arg := range dir.Arguments {
+		value, err := arg.Value.Value(nil)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			args[arg.Name] = value
+		}
+	}
+
+	c, err := graphql.ParseConstraint(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// custom scalars are checked by the kind of their go type, anything else is up to the marshaler
+	switch typ.GQLType {
+	case "String", "ID":
+		if c.HasRange() {
+			return nil, errors.Errorf("min and max cannot be used on %s", typ.GQLType)
+		}
+	case "Int", "Float":
+		if c.HasLength() {
+			return nil, errors.Errorf("minLength, maxLength, pattern and format cannot be used on %s", typ.GQLType)
+		}
+	default:
+		if def := cfg.schema.Types[typ.GQLType]; def == nil || def.Kind != ast.Scalar || typ.GQLType == "Boolean" {
+			return nil, errors.Errorf("cannot be used on %s", typ.GQLType)
+		}
+	}
+
+	return &Constraint{VarName: varName, Args: args}, nil
+}
+
+// markConstrainedInputs flags every input that has a constraint somewhere inside it, so that the fields and
+// arguments using it can add themselves to the path of a failed constraint
+func markConstrainedInputs(inputs Objects) {
+	for changed := true; changed; {
+		changed = false
+		for _, input := range inputs {
+			if input.HasConstraints {
+				continue
+			}
+			for _, field := range input.Fields {
+				if field.Constraint != nil || field.HasConstraints {
+					input.HasConstraints = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
@@ -4,13 +4,22 @@ import (
 	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
 )
 
 func (cfg *Config) buildDirectives(types NamedTypes) ([]*Directive, error) {
 	var directives []*Directive
 
 	for name, dir := range cfg.schema.Directives {
-		if name == "skip" || name == "include" || name == "deprecated" {
+		// @timeout is implemented by the generated field middleware, so it doesn't need a DirectiveRoot entry
+		if name == "timeout" {
+			if arg := dir.Arguments.ForName("ms"); arg == nil || arg.Type.Name() != "Int" {
+				return nil, errors.Errorf("directive @timeout must be declared as @timeout(ms: Int!)")
+			}
+			continue
+		}
+
+		if !cfg.hasDirectiveRoot(name) {
 			continue
 		}
 
@@ -46,3 +55,47 @@ func (cfg *Config) buildDirectives(types NamedTypes) ([]*Directive, error) {
 
 	return directives, nil
 }
+
+// hasDirectiveRoot checks if a directive is executed through the DirectiveRoot. The built in directives are handled
+// by the executor, and codegen and federation directives are never executed.
+func (cfg *Config) hasDirectiveRoot(name string) bool {
+	switch name {
+	case "skip", "include", "deprecated", "timeout":
+		return false
+	}
+	if _, ok := federationDirectives[name]; ok && cfg.Federation {
+		return false
+	}
+	_, ok := codegenDirectives[name]
+	return !ok
+}
+
+// hasSchemaDirectives checks if any object, and any enum value, has a directive with a DirectiveRoot entry
+func (cfg *Config) hasSchemaDirectives() (objects bool, enumValues bool) {
+	for _, def := range cfg.schema.Types {
+		switch def.Kind {
+		case ast.Object:
+			if len(cfg.executedDirectives(def.Directives)) > 0 {
+				objects = true
+			}
+		case ast.Enum:
+			for _, value := range def.EnumValues {
+				if len(cfg.executedDirectives(value.Directives)) > 0 {
+					enumValues = true
+				}
+			}
+		}
+	}
+	return objects, enumValues
+}
+
+// executedDirectives are the names of the directives in a list that have a DirectiveRoot entry
+func (cfg *Config) executedDirectives(directives ast.DirectiveList) []string {
+	var names []string
+	for _, d := range directives {
+		if cfg.hasDirectiveRoot(d.Name) {
+			names = append(names, d.Name)
+		}
+	}
+	return names
+}
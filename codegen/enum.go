@@ -1,5 +1,7 @@
 package codegen
 
+import "strings"
+
 type Enum struct {
 	*NamedType
 	Description string
@@ -10,3 +12,18 @@ type EnumValue struct {
 	Name        string
 	Description string
 }
+
+// ValueNames lists the graphql values, for error messages
+func (e Enum) ValueNames() string {
+	var names []string
+	for _, v := range e.Values {
+		names = append(names, v.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// BoundEnum is a graphql enum bound to an existing go type, eg an int based enum, using the enumValues mapping
+type BoundEnum struct {
+	Enum
+	Constants []*Ref // The go constant for each of the values
+}
@@ -1,10 +1,12 @@
 package codegen
 
 import (
+	"go/types"
 	"sort"
 	"strings"
 
 	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/ast"
 )
 
@@ -37,3 +39,65 @@ func (cfg *Config) buildEnums(types NamedTypes) []Enum {
 
 	return enums
 }
+
+// buildBoundEnums checks the enumValues mapping for enums bound to go types, every graphql value needs a constant of
+// the bound type
+func (cfg *Config) buildBoundEnums(namedTypes NamedTypes, pkgs *pkgLoader) ([]*BoundEnum, error) {
+	var enums []*BoundEnum
+
+	for _, typ := range cfg.schema.Types {
+		entry := cfg.Models[typ.Name]
+		if typ.Kind != ast.Enum || len(entry.EnumValues) == 0 {
+			continue
+		}
+		namedType := namedTypes[typ.Name]
+
+		def, err := findGoType(pkgs, namedType.Package, namedType.GoType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", typ.Name)
+		}
+		if def == nil {
+			return nil, errors.Errorf("%s: cannot find %s.%s", typ.Name, namedType.Package, namedType.GoType)
+		}
+
+		enum := &BoundEnum{Enum: Enum{NamedType: namedType, Description: typ.Description}}
+		mappedTo := map[string]string{}
+		for _, v := range typ.EnumValues {
+			constant, ok := entry.EnumValues[v.Name]
+			if !ok {
+				return nil, errors.Errorf("%s.%s is missing from enumValues", typ.Name, v.Name)
+			}
+
+			pkg, name := pkgAndType(constant)
+			obj, err := findGoType(pkgs, pkg, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s.%s", typ.Name, v.Name)
+			}
+			c, ok := obj.(*types.Const)
+			if !ok || !types.Identical(c.Type(), def.Type()) {
+				return nil, errors.Errorf("%s.%s: %s is not a %s.%s constant", typ.Name, v.Name, constant, namedType.Package, namedType.GoType)
+			}
+			if other, exists := mappedTo[c.Val().ExactString()]; exists {
+				return nil, errors.Errorf("%s.%s and %s.%s are mapped to the same value", typ.Name, other, typ.Name, v.Name)
+			}
+			mappedTo[c.Val().ExactString()] = v.Name
+
+			enum.Values = append(enum.Values, EnumValue{v.Name, v.Description})
+			enum.Constants = append(enum.Constants, &Ref{GoType: name, Package: pkg})
+		}
+
+		for value := range entry.EnumValues {
+			if typ.EnumValues.ForName(value) == nil {
+				return nil, errors.Errorf("%s has no value %s, but it is in enumValues", typ.Name, value)
+			}
+		}
+
+		enums = append(enums, enum)
+	}
+
+	sort.Slice(enums, func(i, j int) bool {
+		return enums[i].GQLType < enums[j].GQLType
+	})
+
+	return enums, nil
+}
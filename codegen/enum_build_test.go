@@ -0,0 +1,60 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundEnumValues(t *testing.T) {
+	schema := `
+		type Query { priority: Priority }
+		enum Priority { LOW HIGH }
+	`
+	priority := func(values map[string]string) TypeMap {
+		return TypeMap{"Priority": {
+			Model:      "github.com/99designs/gqlgen/codegen/testserver.Priority",
+			EnumValues: values,
+		}}
+	}
+
+	t.Run("missing value", func(t *testing.T) {
+		err := generate("boundenummissing", schema, priority(map[string]string{
+			"LOW": "github.com/99designs/gqlgen/codegen/testserver.PriorityLow",
+		}))
+		require.EqualError(t, err, "exec plan failed: Priority.HIGH is missing from enumValues")
+	})
+
+	t.Run("unknown value", func(t *testing.T) {
+		err := generate("boundenumunknown", schema, priority(map[string]string{
+			"LOW":    "github.com/99designs/gqlgen/codegen/testserver.PriorityLow",
+			"HIGH":   "github.com/99designs/gqlgen/codegen/testserver.PriorityHigh",
+			"MEDIUM": "github.com/99designs/gqlgen/codegen/testserver.PriorityUnmapped",
+		}))
+		require.EqualError(t, err, "exec plan failed: Priority has no value MEDIUM, but it is in enumValues")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		err := generate("boundenumtype", schema, priority(map[string]string{
+			"LOW":  "github.com/99designs/gqlgen/codegen/testserver.PriorityLow",
+			"HIGH": "github.com/99designs/gqlgen/codegen/testserver.Rectangle",
+		}))
+		require.EqualError(t, err, "exec plan failed: Priority.HIGH: github.com/99designs/gqlgen/codegen/testserver.Rectangle is not a github.com/99designs/gqlgen/codegen/testserver.Priority constant")
+	})
+
+	t.Run("duplicate value", func(t *testing.T) {
+		err := generate("boundenumduplicate", schema, priority(map[string]string{
+			"LOW":  "github.com/99designs/gqlgen/codegen/testserver.PriorityLow",
+			"HIGH": "github.com/99designs/gqlgen/codegen/testserver.PriorityLow",
+		}))
+		require.EqualError(t, err, "exec plan failed: Priority.LOW and Priority.HIGH are mapped to the same value")
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		err := generate("boundenum", schema, priority(map[string]string{
+			"LOW":  "github.com/99designs/gqlgen/codegen/testserver.PriorityLow",
+			"HIGH": "github.com/99designs/gqlgen/codegen/testserver.PriorityHigh",
+		}))
+		require.NoError(t, err)
+	})
+}
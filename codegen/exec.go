@@ -0,0 +1,92 @@
+package codegen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"syscall"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+const (
+	defaultExecFilename         = "generated.go"
+	defaultExecFilenameTemplate = "{name}.generated.go"
+	generatedHeader             = "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT."
+)
+
+// IsRoot checks if the executable schema itself belongs in this file, rather than just the code for some types
+func (b *Build) IsRoot() bool {
+	return !b.followSchema || b.root
+}
+
+// HasType checks if the code for a graphql type belongs in this file
+func (b *Build) HasType(name string) bool {
+	return !b.followSchema || b.declaredIn[name] == b.filename
+}
+
+// followSchemaExec splits a build into one build per schema file, keyed by the file they are written to. Types that
+// weren't declared in any schema file are written to the root file, along with the executable schema.
+func (cfg *Config) followSchemaExec(base *Build) map[string]*Build {
+	root := cfg.Exec.Filename
+
+	declaredIn := map[string]string{}
+	for _, def := range cfg.schema.Types {
+		declaredIn[def.Name] = root
+		if def.Position != nil && def.Position.Src != nil {
+			declaredIn[def.Name] = cfg.Exec.followSchemaFilename(def.Position.Src.Name)
+		}
+	}
+
+	// only schema files that declare something with generated code get a file, eg not those with just scalars
+	filenames := map[string]bool{root: true}
+	for _, object := range base.Objects {
+		filenames[declaredIn[object.GQLType]] = true
+	}
+	for _, input := range base.Inputs {
+		filenames[declaredIn[input.GQLType]] = true
+	}
+	for _, iface := range base.Interfaces {
+		filenames[declaredIn[iface.GQLType]] = true
+	}
+	for _, enum := range base.BoundEnums {
+		filenames[declaredIn[enum.GQLType]] = true
+	}
+
+	builds := map[string]*Build{}
+	for filename := range filenames {
+		build := *base
+		build.followSchema = true
+		build.root = filename == root
+		build.filename = filename
+		build.declaredIn = declaredIn
+		builds[filename] = &build
+	}
+	return builds
+}
+
+// unlinkGeneratedExec removes the files written by the last generate, so that stale code can't break loading the exec
+// package. With the follow-schema layout only files that were generated by gqlgen are removed.
+func (cfg *Config) unlinkGeneratedExec() {
+	_ = syscall.Unlink(cfg.Exec.Filename)
+	if cfg.Exec.Layout != LayoutFollowSchema {
+		return
+	}
+
+	filenames, _ := filepath.Glob(cfg.Exec.followSchemaFilename("*"))
+	for _, filename := range filenames {
+		src, err := ioutil.ReadFile(filename)
+		if err == nil && bytes.HasPrefix(src, []byte(generatedHeader)) {
+			_ = syscall.Unlink(filename)
+		}
+	}
+}
+
+func generateFollowSchemaExec(cfg *Config, build *Build) error {
+	for filename, build := range cfg.followSchemaExec(build) {
+		if err := templates.RenderToFile("generated.gotpl", filename, build); err != nil {
+			return err
+		}
+	}
+	return nil
+}
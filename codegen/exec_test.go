@@ -0,0 +1,81 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestExecLayoutFollowSchema(t *testing.T) {
+	cfg := func(schema map[string]string) Config {
+		cfg := Config{
+			SchemaStr: schema,
+			Exec:      PackageConfig{DirName: "gen/execfollowschema"},
+			Model:     ModelConfig{PackageConfig: PackageConfig{Filename: "gen/execfollowschema/model.go"}},
+		}
+		for name := range schema {
+			cfg.SchemaFilename = append(cfg.SchemaFilename, name)
+		}
+		return cfg
+	}
+	require.NoError(t, os.RemoveAll("gen/execfollowschema"))
+	require.NoError(t, os.MkdirAll("gen/execfollowschema", 0755))
+	require.NoError(t, ioutil.WriteFile("gen/execfollowschema/helpers.generated.go", []byte("package execfollowschema\n"), 0644))
+
+	err := Generate(cfg(map[string]string{
+		"schema.graphql": `
+			type Query { user(id: Int!): User, search(filter: UserFilter): [User!]! }
+			scalar Time
+		`,
+		"user.graphql": `
+			type User { id: Int!, name: String! }
+			input UserFilter { name: String }
+		`,
+	}))
+	require.NoError(t, err)
+
+	root, err := ioutil.ReadFile("gen/execfollowschema/generated.go")
+	require.NoError(t, err)
+	require.Contains(t, string(root), "func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {")
+	require.Contains(t, string(root), "var parsedSchema = gqlparser.MustLoadSchema(")
+	require.NotContains(t, string(root), "func (ec *executionContext) _Query(")
+	require.NotContains(t, string(root), "func (ec *executionContext) ___Schema(")
+
+	schema, err := ioutil.ReadFile("gen/execfollowschema/schema.generated.go")
+	require.NoError(t, err)
+	require.Contains(t, string(schema), "func (ec *executionContext) _Query(")
+	require.Contains(t, string(schema), "func field_Query_user_args(")
+	require.NotContains(t, string(schema), "func (ec *executionContext) _User(")
+	require.NotContains(t, string(schema), "func NewExecutableSchema(")
+
+	user, err := ioutil.ReadFile("gen/execfollowschema/user.generated.go")
+	require.NoError(t, err)
+	require.Contains(t, string(user), "func (ec *executionContext) _User(")
+	require.Contains(t, string(user), "func UnmarshalUserFilter(")
+	require.NotContains(t, string(user), "\"time\"", "imports are managed per file")
+
+	prelude, err := ioutil.ReadFile("gen/execfollowschema/prelude.generated.go")
+	require.NoError(t, err)
+	require.Contains(t, string(prelude), "func (ec *executionContext) ___Schema(")
+
+	// files for schemas that have gone away are removed, but only if gqlgen wrote them
+	err = Generate(cfg(map[string]string{
+		"schema.graphql": `type Query { user(id: Int!): User }`,
+		"users.graphql":  `type User { id: Int!, name: String! }`,
+	}))
+	require.NoError(t, err)
+
+	_, err = os.Stat("gen/execfollowschema/user.generated.go")
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat("gen/execfollowschema/users.generated.go")
+	require.NoError(t, err)
+	_, err = os.Stat("gen/execfollowschema/helpers.generated.go")
+	require.NoError(t, err)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/execfollowschema")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+}
@@ -0,0 +1,292 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/parser"
+)
+
+// federationFilename is the name of the schema source that declares the types apollo federation adds to a service
+const federationFilename = "gqlgen_federation.graphql"
+
+// federationDirectives are only read by the gateway, so they are never executed through the DirectiveRoot
+var federationDirectives = map[string]string{
+	"external": `directive @external on FIELD_DEFINITION`,
+	"requires": `directive @requires(fields: _FieldSet!) on FIELD_DEFINITION`,
+	"provides": `directive @provides(fields: _FieldSet!) on FIELD_DEFINITION`,
+	"key":      `directive @key(fields: _FieldSet!) on OBJECT | INTERFACE`,
+	"extends":  `directive @extends on OBJECT | INTERFACE`,
+}
+
+var federationDirectiveNames = []string{"external", "requires", "provides", "key", "extends"}
+
+// Federation resolves the fields a gateway uses to compose the service into a federated graph
+type Federation struct {
+	Entities *Field // Query._entities, nil when no type has a @key
+	Service  *Field // Query._service
+	// SDL is the schema served to the gateway, without the types declared for federation
+	SDL string
+	// Types are the entities, each of them can be found by any of its keys
+	Types []*Entity
+	// Resolver is the EntityResolver, with a method that finds each entity by one of its keys
+	Resolver *Object
+}
+
+// Entity is a type with a @key, Finders has the EntityResolver method for each key in the order they are declared
+type Entity struct {
+	Name    string
+	Finders []Field
+}
+
+// federationSchema declares the federation directives, scalars and query fields that the schema doesn't already
+// declare, and binds the federation types to the runtime
+func (cfg *Config) federationSchema() error {
+	var sources []*ast.Source
+	for filename, input := range cfg.SchemaStr {
+		if filename == federationFilename {
+			continue
+		}
+		sources = append(sources, &ast.Source{Name: filename, Input: input})
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Name < sources[j].Name
+	})
+
+	source, err := federationSource(sources)
+	if err != nil {
+		return err
+	}
+
+	// the map may be shared with the caller, so it is replaced rather than changed
+	schemaStr := make(map[string]string, len(cfg.SchemaStr)+1)
+	for filename, input := range cfg.SchemaStr {
+		schemaStr[filename] = input
+	}
+	schemaStr[federationFilename] = source
+	cfg.SchemaStr = schemaStr
+
+	builtins := TypeMap{
+		"_Any":      {Model: "github.com/99designs/gqlgen/graphql.Map"},
+		"_FieldSet": {Model: "github.com/99designs/gqlgen/graphql.String"},
+		"_Service":  {Model: "github.com/99designs/gqlgen/graphql.FederationService"},
+		"_Entity":   {Model: "github.com/99designs/gqlgen/graphql.Entity"},
+	}
+	for typeName, entry := range builtins {
+		if !cfg.Models.Exists(typeName) {
+			cfg.Models[typeName] = entry
+		}
+	}
+	return nil
+}
+
+// federationSource declares the federation directives and scalars, _Service, the _Entity union of every type with a
+// @key and the _service and _entities query fields, when the schema doesn't already declare them
+func federationSource(sources []*ast.Source) (string, error) {
+	doc, parseErr := parser.ParseSchemas(sources...)
+	if parseErr != nil {
+		return "", parseErr
+	}
+
+	declared := map[string]bool{}
+	for _, def := range doc.Definitions {
+		declared[def.Name] = true
+	}
+
+	queryName := "Query"
+	for _, schema := range append(doc.Schema, doc.SchemaExtension...) {
+		for _, op := range schema.OperationTypes {
+			if op.Operation == ast.Query {
+				queryName = op.Type
+			}
+		}
+	}
+
+	entities := map[string]bool{}
+	queryFields := map[string]bool{}
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		if def.Kind == ast.Object && def.Directives.ForName("key") != nil {
+			entities[def.Name] = true
+		}
+		if def.Name == queryName {
+			for _, field := range def.Fields {
+				queryFields[field.Name] = true
+			}
+		}
+	}
+
+	var decls []string
+	for _, name := range federationDirectiveNames {
+		if doc.Directives.ForName(name) == nil {
+			decls = append(decls, federationDirectives[name])
+		}
+	}
+	for _, scalar := range []string{"_Any", "_FieldSet"} {
+		if !declared[scalar] {
+			decls = append(decls, "scalar "+scalar)
+		}
+	}
+	if !declared["_Service"] {
+		decls = append(decls, "type _Service {\n\tsdl: String\n}")
+	}
+
+	var entityNames []string
+	for name := range entities {
+		entityNames = append(entityNames, name)
+	}
+	sort.Strings(entityNames)
+	if len(entityNames) > 0 && !declared["_Entity"] {
+		decls = append(decls, "union _Entity = "+strings.Join(entityNames, " | "))
+	}
+
+	var fields []string
+	if len(entityNames) > 0 && !queryFields["_entities"] {
+		fields = append(fields, "\t_entities(representations: [_Any!]!): [_Entity]!")
+	}
+	if !queryFields["_service"] {
+		fields = append(fields, "\t_service: _Service!")
+	}
+	if len(fields) > 0 {
+		decls = append(decls, fmt.Sprintf("extend type %s {\n%s\n}", queryName, strings.Join(fields, "\n")))
+	}
+
+	return strings.Join(decls, "\n\n") + "\n", nil
+}
+
+// federationSDL joins the schema files, leaving out the declarations added for federation
+func federationSDL(schemaRaw map[string]string) string {
+	var filenames []string
+	for filename := range schemaRaw {
+		if filename != federationFilename {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+
+	var sdl []string
+	for _, filename := range filenames {
+		sdl = append(sdl, strings.TrimSpace(schemaRaw[filename]))
+	}
+	return strings.Join(sdl, "\n\n") + "\n"
+}
+
+// buildFederation binds the _service and _entities query fields to the generated resolvers, and builds the
+// EntityResolver from the keys of each entity
+func (cfg *Config) buildFederation(types NamedTypes, objects Objects) (*Federation, error) {
+	query := objects.ByName(cfg.schema.Query.Name)
+	federation := &Federation{
+		Resolver: &Object{
+			NamedType:         &NamedType{GQLType: "Entity"},
+			ResolverInterface: &Ref{GoType: "EntityResolver", Package: cfg.Exec.ImportPath()},
+			Root:              true,
+		},
+	}
+	if obj := objects.ByName("Entity"); obj != nil && obj.HasResolvers() {
+		return nil, errors.Errorf("federation: the resolvers for Entity would clash with the EntityResolver")
+	}
+
+	for i := range query.Fields {
+		field := &query.Fields[i]
+		switch field.GQLName {
+		case "_entities":
+			federation.Entities = field
+		case "_service":
+			federation.Service = field
+		}
+	}
+	if federation.Service == nil || federation.Service.ASTType.String() != "_Service!" || len(federation.Service.Args) != 0 {
+		return nil, errors.Errorf("federation: %s._service must be declared as _service: _Service!", query.GQLType)
+	}
+	federation.Service.bindDispatcher("resolveService")
+
+	for _, def := range cfg.schema.Types {
+		if def.Kind != ast.Object || def.Directives.ForName("key") == nil {
+			continue
+		}
+		entity, err := cfg.buildEntity(types, objects.ByName(def.Name), def, federation.Resolver)
+		if err != nil {
+			return nil, errors.Wrap(err, "federation")
+		}
+		federation.Types = append(federation.Types, entity)
+		federation.Resolver.Fields = append(federation.Resolver.Fields, entity.Finders...)
+	}
+	sort.Slice(federation.Types, func(i, j int) bool {
+		return federation.Types[i].Name < federation.Types[j].Name
+	})
+	sort.Slice(federation.Resolver.Fields, func(i, j int) bool {
+		return federation.Resolver.Fields[i].GQLName < federation.Resolver.Fields[j].GQLName
+	})
+
+	if len(federation.Types) > 0 {
+		entities := federation.Entities
+		if entities == nil || entities.ASTType.String() != "[_Entity]!" || len(entities.Args) != 1 || entities.Args[0].GQLName != "representations" || entities.Args[0].ASTType.String() != "[_Any!]!" {
+			return nil, errors.Errorf("federation: %s._entities must be declared as _entities(representations: [_Any!]!): [_Entity]!", query.GQLType)
+		}
+		entities.bindDispatcher("resolveEntities")
+	}
+
+	return federation, nil
+}
+
+// buildEntity adds a find method to the EntityResolver for each @key on a type, eg @key(fields: "upc sku") becomes
+// FindProductByUpcAndSku(ctx context.Context, upc string, sku string) (*Product, error)
+func (cfg *Config) buildEntity(types NamedTypes, obj *Object, def *ast.Definition, resolver *Object) (*Entity, error) {
+	entity := &Entity{Name: def.Name}
+	for _, dir := range def.Directives {
+		if dir.Name != "key" {
+			continue
+		}
+		fieldSet, err := directiveArg(dir, "fields")
+		if err != nil {
+			return nil, err
+		}
+		fieldSetStr, _ := fieldSet.(string)
+		keyFields := strings.Fields(strings.Replace(fieldSetStr, ",", " ", -1))
+		if len(keyFields) == 0 {
+			return nil, errors.Errorf("@key on %s must name at least one field", def.Name)
+		}
+		if strings.ContainsAny(fieldSetStr, "{}") {
+			return nil, errors.Errorf("@key(fields: %q) on %s, keys with nested fields are not supported", fieldSetStr, def.Name)
+		}
+
+		find := Field{
+			Type:   &Type{NamedType: types[def.Name], Modifiers: []string{modPtr}, ASTType: ast.NamedType(def.Name, nil)},
+			Object: resolver,
+		}
+		var names []string
+		for _, name := range keyFields {
+			var field *Field
+			for i := range obj.Fields {
+				if obj.Fields[i].GQLName == name {
+					field = &obj.Fields[i]
+				}
+			}
+			if field == nil {
+				return nil, errors.Errorf("@key(fields: %q) on %s, %s is not a field of %s", fieldSetStr, def.Name, name, def.Name)
+			}
+			if !field.IsScalar || len(field.Args) > 0 {
+				return nil, errors.Errorf("@key(fields: %q) on %s, %s must be a scalar or enum without arguments", fieldSetStr, def.Name, name)
+			}
+
+			find.Args = append(find.Args, FieldArgument{
+				GQLName:   name,
+				GoVarName: sanitizeArgName(name),
+				Type:      field.Type,
+				Object:    resolver,
+			})
+			names = append(names, ucFirst(name))
+		}
+		find.GQLName = "find" + def.Name + "By" + strings.Join(names, "And")
+
+		for _, existing := range entity.Finders {
+			if existing.GQLName == find.GQLName {
+				return nil, errors.Errorf("@key(fields: %q) on %s is declared more than once", fieldSetStr, def.Name)
+			}
+		}
+		entity.Finders = append(entity.Finders, find)
+	}
+	return entity, nil
+}
@@ -0,0 +1,122 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestFederationSource(t *testing.T) {
+	source, err := federationSource([]*ast.Source{{Input: `
+		schema { query: Root }
+		type Root { me: User }
+		type User @key(fields: "id") { id: ID! }
+		type Product @key(fields: "upc") @extends { upc: String! @external }
+		type Review { body: String! }
+		directive @external on FIELD_DEFINITION
+		scalar _Any
+	`}})
+	require.NoError(t, err)
+	require.Equal(t, `directive @requires(fields: _FieldSet!) on FIELD_DEFINITION
+
+directive @provides(fields: _FieldSet!) on FIELD_DEFINITION
+
+directive @key(fields: _FieldSet!) on OBJECT | INTERFACE
+
+directive @extends on OBJECT | INTERFACE
+
+scalar _FieldSet
+
+type _Service {
+	sdl: String
+}
+
+union _Entity = Product | User
+
+extend type Root {
+	_entities(representations: [_Any!]!): [_Entity]!
+	_service: _Service!
+}
+`, source)
+
+	source, err = federationSource([]*ast.Source{{Input: `type Query { me: String }`}})
+	require.NoError(t, err)
+	require.NotContains(t, source, "_Entity")
+	require.Contains(t, source, "extend type Query {\n\t_service: _Service!\n}")
+}
+
+func TestFederation(t *testing.T) {
+	_ = syscall.Unlink("gen/federation/resolver/resolver.go")
+
+	err := Generate(Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			type Query { me: User }
+			type User @key(fields: "id") @key(fields: "org name") { id: ID!, org: Int!, name: String! }
+			type Product @key(fields: "upc") @extends {
+				upc: String! @external
+				weight: Int @external
+				shippingEstimate: Int @requires(fields: "weight")
+			}
+		`},
+		Exec:       PackageConfig{Filename: "gen/federation/exec.go"},
+		Model:      ModelConfig{PackageConfig: PackageConfig{Filename: "gen/federation/model.go"}},
+		Resolver:   PackageConfig{Filename: "gen/federation/resolver/resolver.go", Type: "Resolver"},
+		Federation: true,
+	})
+	require.NoError(t, err)
+
+	exec, err := ioutil.ReadFile("gen/federation/exec.go")
+	require.NoError(t, err)
+	require.Contains(t, string(exec), "Entity() EntityResolver")
+	require.Contains(t, string(exec), "type EntityResolver interface {\n"+
+		"\tFindProductByUpc(ctx context.Context, upc string) (*Product, error)\n"+
+		"\tFindUserByID(ctx context.Context, id string) (*User, error)\n"+
+		"\tFindUserByOrgAndName(ctx context.Context, org int, name string) (*User, error)\n}")
+	require.Contains(t, string(exec), "return ec.resolveEntities(ctx, args[\"representations\"].([]map[string]interface{}))")
+	require.Contains(t, string(exec), "return ec.resolveService(ctx)")
+	require.Contains(t, string(exec), "const federationSDL = `type Query { me: User }")
+	require.NotContains(t, string(exec), "Key func(")
+
+	resolver, err := ioutil.ReadFile("gen/federation/resolver/resolver.go")
+	require.NoError(t, err)
+	require.Contains(t, string(resolver), "func (r *Resolver) Entity() federation.EntityResolver {")
+	require.Contains(t, string(resolver), "func (r *entityResolver) FindUserByOrgAndName(ctx context.Context, org int, name string) (*federation.User, error) {")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/federation", "./gen/federation/resolver")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+
+	t.Run("keys must be fields of the entity", func(t *testing.T) {
+		err := Generate(Config{
+			SchemaFilename: SchemaFilenames{"schema.graphql"},
+			SchemaStr: map[string]string{"schema.graphql": `
+				type Query { me: User }
+				type User @key(fields: "id email") { id: ID! }
+			`},
+			Exec:       PackageConfig{Filename: "gen/federation/exec.go"},
+			Model:      ModelConfig{PackageConfig: PackageConfig{Filename: "gen/federation/model.go"}},
+			Federation: true,
+		})
+		require.EqualError(t, err, `exec plan failed: federation: @key(fields: "id email") on User, email is not a field of User`)
+	})
+
+	t.Run("nested keys are not supported", func(t *testing.T) {
+		err := Generate(Config{
+			SchemaFilename: SchemaFilenames{"schema.graphql"},
+			SchemaStr: map[string]string{"schema.graphql": `
+				type Query { me: User }
+				type Org { id: ID! }
+				type User @key(fields: "id org { id }") { id: ID!, org: Org! }
+			`},
+			Exec:       PackageConfig{Filename: "gen/federation/exec.go"},
+			Model:      ModelConfig{PackageConfig: PackageConfig{Filename: "gen/federation/model.go"}},
+			Federation: true,
+		})
+		require.EqualError(t, err, `exec plan failed: federation: @key(fields: "id org { id }") on User, keys with nested fields are not supported`)
+	})
+}
@@ -6,10 +6,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/ast"
-	"golang.org/x/tools/go/loader"
 )
 
-func (cfg *Config) buildInputs(namedTypes NamedTypes, prog *loader.Program) (Objects, error) {
+func (cfg *Config) buildInputs(namedTypes NamedTypes, pkgs *pkgLoader) (Objects, error) {
 	var inputs Objects
 
 	for _, typ := range cfg.schema.Types {
@@ -20,7 +19,7 @@ func (cfg *Config) buildInputs(namedTypes NamedTypes, prog *loader.Program) (Obj
 				return nil, err
 			}
 
-			def, err := findGoType(prog, input.Package, input.GoType)
+			def, err := findGoType(pkgs, input.Package, input.GoType)
 			if err != nil {
 				return nil, errors.Wrap(err, "cannot find type")
 			}
@@ -30,11 +29,16 @@ func (cfg *Config) buildInputs(namedTypes NamedTypes, prog *loader.Program) (Obj
 				if len(bindErrs) > 0 {
 					return nil, bindErrs
 				}
+				if input.HasIsSet && input.Marshaler != nil && !hasIsSetField(def.Type()) {
+					return nil, errors.Errorf("%s needs a %s map[string]bool field to record which fields were given", input.FullName(), isSetField)
+				}
 			}
 
 			inputs = append(inputs, input)
 		}
 	}
+	markConstrainedInputs(inputs)
+	markInputDirectives(inputs)
 
 	sort.Slice(inputs, func(i, j int) bool {
 		return inputs[i].GQLType < inputs[j].GQLType
@@ -46,6 +50,7 @@ func (cfg *Config) buildInputs(namedTypes NamedTypes, prog *loader.Program) (Obj
 func (cfg *Config) buildInput(types NamedTypes, typ *ast.Definition) (*Object, error) {
 	obj := &Object{NamedType: types[typ.Name]}
 	typeEntry, entryExists := cfg.Models[typ.Name]
+	obj.HasIsSet = cfg.InputIsSet || typeEntry.IsSet
 
 	for _, field := range typ.Fields {
 		newField := Field{
@@ -72,12 +77,61 @@ func (cfg *Config) buildInput(types NamedTypes, typ *ast.Definition) (*Object, e
 			return nil, errors.Errorf("%s cannot be used as a field of %s. only input and scalar types are allowed", newField.GQLType, obj.GQLType)
 		}
 
+		var err error
+		newField.Constraint, err = cfg.buildConstraint(field.Directives, newField.Type, "constraint_"+typ.Name+"_"+field.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "@constraint on %s.%s", typ.Name, field.Name)
+		}
+		newField.Directives = cfg.executedDirectives(field.Directives)
+
+		if obj.HasIsSet && (newField.GoFieldName == isSetField || newField.GoFieldName == "" && newField.GoNameExported() == isSetField) {
+			return nil, errors.Errorf("%s.%s collides with the %s map, rename it with fieldName", obj.GQLType, field.Name, isSetField)
+		}
+
 		obj.Fields = append(obj.Fields, newField)
 
 	}
 	return obj, nil
 }
 
+// markInputDirectives flags every generated input that has a directive to run on any of its fields, including
+// nested inputs
+func markInputDirectives(inputs Objects) {
+	for changed := true; changed; {
+		changed = false
+		for _, input := range inputs {
+			if input.HasInputDirectives || !input.IsMarshaled() {
+				continue
+			}
+			for _, field := range input.Fields {
+				if len(field.Directives) > 0 || field.HasInputDirectives {
+					input.HasInputDirectives = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+const isSetField = "IsSet"
+
+// hasIsSetField checks if a bound input type can record which fields were given
+func hasIsSetField(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, isSetField)
+	field, ok := obj.(*types.Var)
+	if !ok || !field.IsField() {
+		return false
+	}
+	m, ok := field.Type().(*types.Map)
+	if !ok {
+		return false
+	}
+	key, keyOk := m.Key().(*types.Basic)
+	elem, elemOk := m.Elem().(*types.Basic)
+	return keyOk && elemOk && key.Kind() == types.String && elem.Kind() == types.Bool
+}
+
 // if user has implemented an UnmarshalGQL method on the input type manually, use it
 // otherwise we will generate one.
 func buildInputMarshaler(typ *ast.Definition, def types.Object) *Ref {
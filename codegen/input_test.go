@@ -4,7 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
 func TestTypeUnionAsInput(t *testing.T) {
@@ -33,12 +33,49 @@ func TestTypeInInput(t *testing.T) {
 	require.EqualError(t, err, "model plan failed: Item cannot be used as a field of BookmarkableInput. only input and scalar types are allowed")
 }
 
+func TestInputIsSetCollision(t *testing.T) {
+	err := generate("inputisset", `
+		type Query {
+			update(input: UpdateInput!): Boolean!
+		}
+		input UpdateInput {
+			isSet: Boolean
+		}
+	`, TypeMap{"UpdateInput": {IsSet: true}})
+
+	require.EqualError(t, err, "model plan failed: UpdateInput.isSet collides with the IsSet map, rename it with fieldName")
+}
+
+func TestInvalidConstraints(t *testing.T) {
+	err := generate("constraintpattern", `
+		type Query { search(query: String @constraint(pattern: "[a-z")): Boolean! }
+	`)
+	require.EqualError(t, err, "model plan failed: @constraint on Query.search(query): pattern is not a valid regexp: error parsing regexp: missing closing ]: `[a-z`")
+
+	err = generate("constraintrange", `
+		type Query { search(input: SearchInput): Boolean! }
+		input SearchInput { query: String @constraint(min: 1) }
+	`)
+	require.EqualError(t, err, "model plan failed: @constraint on SearchInput.query: min and max cannot be used on String")
+
+	err = generate("constraintlength", `
+		type Query { search(limit: Int @constraint(maxLength: 10)): Boolean! }
+	`)
+	require.EqualError(t, err, "model plan failed: @constraint on Query.search(limit): minLength, maxLength, pattern and format cannot be used on Int")
+
+	err = generate("constraintinput", `
+		type Query { search(input: SearchInput @constraint(minLength: 1)): Boolean! }
+		input SearchInput { query: String }
+	`)
+	require.EqualError(t, err, "model plan failed: @constraint on Query.search(input): cannot be used on SearchInput")
+}
+
 func generate(name string, schema string, typemap ...TypeMap) error {
 	cfg := Config{
 		SchemaFilename: SchemaFilenames{"schema.graphql"},
 		SchemaStr:      map[string]string{"schema.graphql": schema},
 		Exec:           PackageConfig{Filename: "gen/" + name + "/exec.go"},
-		Model:          PackageConfig{Filename: "gen/" + name + "/model.go"},
+		Model:          ModelConfig{PackageConfig: PackageConfig{Filename: "gen/" + name + "/model.go"}},
 	}
 
 	if len(typemap) > 0 {
@@ -46,13 +83,13 @@ func generate(name string, schema string, typemap ...TypeMap) error {
 	}
 	err := Generate(cfg)
 	if err == nil {
-		conf := loader.Config{}
-		conf.Import("github.com/99designs/gqlgen/codegen/testdata/gen/" + name)
-
-		_, err = conf.Load()
+		pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/"+name)
 		if err != nil {
 			panic(err)
 		}
+		if packages.PrintErrors(pkgs) > 0 {
+			panic("generated code does not compile")
+		}
 	}
 	return err
 }
@@ -4,15 +4,19 @@ import (
 	"go/types"
 	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/ast"
-	"golang.org/x/tools/go/loader"
 )
 
-func (cfg *Config) buildInterfaces(types NamedTypes, prog *loader.Program) []*Interface {
+func (cfg *Config) buildInterfaces(types NamedTypes, pkgs *pkgLoader) ([]*Interface, error) {
 	var interfaces []*Interface
 	for _, typ := range cfg.schema.Types {
 		if typ.Kind == ast.Union || typ.Kind == ast.Interface {
-			interfaces = append(interfaces, cfg.buildInterface(types, typ, prog))
+			intf := cfg.buildInterface(types, typ, pkgs)
+			if err := bindInterface(types, typ, intf, pkgs); err != nil {
+				return nil, err
+			}
+			interfaces = append(interfaces, intf)
 		}
 	}
 
@@ -20,10 +24,10 @@ func (cfg *Config) buildInterfaces(types NamedTypes, prog *loader.Program) []*In
 		return interfaces[i].GQLType < interfaces[j].GQLType
 	})
 
-	return interfaces
+	return interfaces, nil
 }
 
-func (cfg *Config) buildInterface(types NamedTypes, typ *ast.Definition, prog *loader.Program) *Interface {
+func (cfg *Config) buildInterface(types NamedTypes, typ *ast.Definition, pkgs *pkgLoader) *Interface {
 	i := &Interface{NamedType: types[typ.Name]}
 
 	for _, implementor := range cfg.schema.GetPossibleTypes(typ) {
@@ -31,23 +35,87 @@ func (cfg *Config) buildInterface(types NamedTypes, typ *ast.Definition, prog *l
 
 		i.Implementors = append(i.Implementors, InterfaceImplementor{
 			NamedType:     t,
-			ValueReceiver: cfg.isValueReceiver(types[typ.Name], t, prog),
+			ValueReceiver: cfg.isValueReceiver(types[typ.Name], t, pkgs),
 		})
 	}
 
 	return i
 }
 
-func (cfg *Config) isValueReceiver(intf *NamedType, implementor *NamedType, prog *loader.Program) bool {
-	interfaceType, err := findGoInterface(prog, intf.Package, intf.GoType)
+func (cfg *Config) isValueReceiver(intf *NamedType, implementor *NamedType, pkgs *pkgLoader) bool {
+	interfaceType, err := findGoInterface(pkgs, intf.Package, intf.GoType)
 	if interfaceType == nil || err != nil {
 		return true
 	}
 
-	implementorType, err := findGoNamedType(prog, implementor.Package, implementor.GoType)
+	implementorType, err := findGoNamedType(pkgs, implementor.Package, implementor.GoType)
 	if implementorType == nil || err != nil {
 		return true
 	}
 
 	return types.Implements(implementorType, interfaceType)
 }
+
+// bindInterface checks an interface or union bound to an existing go interface. Every implementor must implement it,
+// and on interfaces every method must either be a marker like IsShape() or return one of the schema fields.
+func bindInterface(namedTypes NamedTypes, def *ast.Definition, intf *Interface, pkgs *pkgLoader) error {
+	named, err := findGoNamedType(pkgs, intf.Package, intf.GoType)
+	if named == nil || err != nil {
+		return nil
+	}
+	interfaceType, ok := named.Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+
+	for i := 0; i < interfaceType.NumMethods(); i++ {
+		method := interfaceType.Method(i)
+		sig := method.Type().(*types.Signature)
+		if def.Kind != ast.Interface || sig.Params().Len() == 0 && sig.Results().Len() == 0 {
+			continue
+		}
+
+		field := getterField(def, method.Name())
+		if field == nil {
+			return errors.Errorf("%s.%s does not match a field on %s", named.String(), method.Name(), def.Name)
+		}
+		if sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+			return errors.Errorf("%s.%s must take no arguments and return a single value", named.String(), method.Name())
+		}
+		if err := validateTypeBinding(&Field{Type: namedTypes.getType(field.Type)}, sig.Results().At(0).Type()); err != nil {
+			return errors.Wrapf(err, "%s.%s has the wrong return type", named.String(), method.Name())
+		}
+	}
+
+	for _, implementor := range intf.Implementors {
+		implementorType, err := findGoNamedType(pkgs, implementor.Package, implementor.GoType)
+		if implementorType == nil || err != nil {
+			continue
+		}
+		ptr := types.NewPointer(implementorType)
+		if types.Implements(ptr, interfaceType) {
+			continue
+		}
+
+		missing, wrongType := types.MissingMethod(ptr, interfaceType, true)
+		if wrongType {
+			return errors.Errorf("%s does not implement %s: %s has the wrong signature", implementorType.String(), named.String(), missing.Name())
+		}
+		return errors.Errorf("%s does not implement %s: missing method %s", implementorType.String(), named.String(), missing.Name())
+	}
+
+	return nil
+}
+
+// getterField finds the interface field returned by a method, either GetName() or Name()
+func getterField(def *ast.Definition, methodName string) *ast.FieldDefinition {
+	for _, field := range def.Fields {
+		if len(field.Arguments) > 0 {
+			continue
+		}
+		if equalFieldName(methodName, "Get"+field.Name) || equalFieldName(methodName, field.Name) {
+			return field
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"go/types"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestInterfaceGetters(t *testing.T) {
+	err := generate("interfacegetters", `
+		type Query { node: Node }
+		interface Node {
+			id: ID!
+			parent: Node
+		}
+		interface Named { name: String }
+		type User implements Node & Named {
+			id: ID!
+			parent: Node
+			name: String
+		}
+		type Org implements Node & Named {
+			id: ID!
+			parent: Org
+			name: String
+		}
+	`)
+	require.NoError(t, err)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/interfacegetters")
+	require.NoError(t, err)
+	scope := pkgs[0].Types.Scope()
+
+	methods := func(name string) []string {
+		var res []string
+		mset := types.NewMethodSet(scope.Lookup(name).Type())
+		for i := 0; i < mset.Len(); i++ {
+			res = append(res, mset.At(i).Obj().Name()+" "+mset.At(i).Type().(*types.Signature).String())
+		}
+		sort.Strings(res)
+		return res
+	}
+
+	// Org.parent is narrower than Node.parent, so only User can implement GetParent
+	require.Equal(t, []string{"GetID func() string", "IsNode func()"}, methods("Node"))
+	require.Equal(t, []string{"GetName func() *string", "IsNamed func()"}, methods("Named"))
+	require.Equal(t, []string{
+		"GetID func() string",
+		"GetName func() *string",
+		"GetParent func() github.com/99designs/gqlgen/codegen/gen/interfacegetters.Node",
+		"IsNamed func()",
+		"IsNode func()",
+	}, methods("User"))
+	require.Equal(t, []string{"GetID func() string", "GetName func() *string", "IsNamed func()", "IsNode func()"}, methods("Org"))
+}
+
+func TestBindInterface(t *testing.T) {
+	shapes := func(field string) string {
+		return `
+			type Query { shapes: [Shape] }
+			interface Shape { ` + field + ` }
+			type Circle implements Shape { radius: Float, ` + field + ` }
+			type Rectangle implements Shape { length: Float, width: Float, ` + field + ` }
+		`
+	}
+	typemap := func() TypeMap {
+		return TypeMap{
+			"Shape":     {Model: "github.com/99designs/gqlgen/codegen/testserver.Shape"},
+			"Circle":    {Model: "github.com/99designs/gqlgen/codegen/testserver.Circle"},
+			"Rectangle": {Model: "github.com/99designs/gqlgen/codegen/testserver.Rectangle"},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		err := generate("bindinterface", shapes(`area: Float`), typemap())
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		err := generate("bindinterfaceunknown", shapes(`size: Float`), typemap())
+		require.EqualError(t, err, "exec plan failed: github.com/99designs/gqlgen/codegen/testserver.Shape.Area does not match a field on Shape")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		err := generate("bindinterfacetype", shapes(`area: String`), typemap())
+		require.EqualError(t, err, "exec plan failed: github.com/99designs/gqlgen/codegen/testserver.Shape.Area has the wrong return type: *string is not compatible with float64")
+	})
+
+	t.Run("implementor missing methods", func(t *testing.T) {
+		types := typemap()
+		delete(types, "Rectangle")
+		err := generate("bindinterfaceimplementor", shapes(`area: Float`), types)
+		require.EqualError(t, err, "exec plan failed: github.com/99designs/gqlgen/codegen/gen/bindinterfaceimplementor.Rectangle does not implement github.com/99designs/gqlgen/codegen/testserver.Shape: missing method Area")
+	})
+}
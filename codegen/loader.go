@@ -0,0 +1,31 @@
+package codegen
+
+import (
+	"time"
+)
+
+type Loader struct {
+	Name     string        // The name of the loader, used to prefix all of the generated types
+	Key      *Ref          // The go type used to look up values
+	Value    *Ref          // The go type being loaded
+	Slice    bool          // Each key loads a slice of values rather than a single value
+	Pointer  bool          // Values are loaded by pointer, false for interfaces
+	Wait     time.Duration // The default time to wait for more keys before a batch is fetched
+	MaxBatch int           // The default maximum number of keys in a single batch, 0 means no limit
+}
+
+// Result is the go type returned when loading a single key
+func (l *Loader) Result() string {
+	if l.Slice {
+		return "[]" + l.Value.FullName()
+	}
+	if l.Pointer {
+		return "*" + l.Value.FullName()
+	}
+	return l.Value.FullName()
+}
+
+// WaitNanos is the default wait as a literal that can be embedded in the generated code
+func (l *Loader) WaitNanos() int64 {
+	return l.Wait.Nanoseconds()
+}
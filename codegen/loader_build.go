@@ -0,0 +1,63 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/pkg/errors"
+)
+
+const defaultLoaderWait = time.Millisecond
+
+func (cfg *Config) buildLoaders(types NamedTypes) ([]*Loader, error) {
+	var loaders []*Loader
+
+	for name, loaderCfg := range cfg.Loaders {
+		loader := &Loader{
+			Name:     templates.ToCamel(name),
+			Slice:    loaderCfg.Slice,
+			Wait:     defaultLoaderWait,
+			MaxBatch: loaderCfg.MaxBatch,
+		}
+
+		keyPkg, keyType := pkgAndType(loaderCfg.Key)
+		loader.Key = &Ref{GoType: keyType, Package: keyPkg}
+
+		typeName := loaderCfg.Type
+		if typeName == "" {
+			typeName = name
+		}
+		if namedType, ok := types[typeName]; ok {
+			if namedType.IsInput || namedType.IsScalar {
+				return nil, fmt.Errorf("loader %s: %s must be an object, interface or union type", name, typeName)
+			}
+			loader.Value = &Ref{GoType: namedType.GoType, Package: namedType.Package}
+			loader.Pointer = !namedType.IsInterface
+		} else {
+			valuePkg, valueType := pkgAndType(typeName)
+			if valuePkg == "" {
+				return nil, fmt.Errorf("loader %s: %s is not a type in the schema or a fully qualified go type", name, typeName)
+			}
+			loader.Value = &Ref{GoType: valueType, Package: valuePkg}
+			loader.Pointer = true
+		}
+
+		if loaderCfg.Wait != "" {
+			wait, err := time.ParseDuration(loaderCfg.Wait)
+			if err != nil {
+				return nil, errors.Wrapf(err, "loader %s: invalid wait", name)
+			}
+			loader.Wait = wait
+		}
+
+		loaders = append(loaders, loader)
+	}
+
+	sort.Slice(loaders, func(i, j int) bool {
+		return loaders[i].Name < loaders[j].Name
+	})
+
+	return loaders, nil
+}
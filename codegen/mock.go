@@ -0,0 +1,256 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// mockDepth is how deep mocked structs are filled in, deeper structs are left empty so that types that refer to
+// themselves don't recurse forever. Resolver fields aren't filled in, they are mocked when they are resolved.
+const mockDepth = 3
+
+type MockBuild struct {
+	PackageName  string
+	MockType     string
+	ResolverRoot *Ref
+	Objects      Objects // the objects with resolvers, each of them gets a mock resolver
+	Models       Objects // the objects that resolvers return, each of them gets a function that fills in its fields
+	Depth        int
+
+	cfg        *Config
+	interfaces map[string]*Interface
+	values     map[string]string // the @mock(value:) on each field, keyed by Type.field
+}
+
+// Create the mock resolver, and the mock functions for the models it returns, from the objects bound for the exec
+// package
+func (cfg *Config) mock(exec *Build) (*MockBuild, error) {
+	build := &MockBuild{
+		PackageName:  cfg.Mock.Package,
+		MockType:     cfg.Mock.Type,
+		ResolverRoot: &Ref{GoType: "ResolverRoot", Package: cfg.Exec.ImportPath()},
+		Depth:        mockDepth,
+		cfg:          cfg,
+		interfaces:   map[string]*Interface{},
+		values:       map[string]string{},
+	}
+	for _, intf := range exec.Interfaces {
+		build.interfaces[intf.GQLType] = intf
+	}
+	for _, obj := range exec.Objects {
+		if obj.HasResolvers() {
+			build.Objects = append(build.Objects, obj)
+		}
+		if !obj.Root && !obj.IsReserved() {
+			build.Models = append(build.Models, obj)
+		}
+	}
+	if exec.Relay != nil && exec.Relay.Resolver.HasResolvers() {
+		build.Objects = append(build.Objects, exec.Relay.Resolver)
+	}
+	if exec.Federation != nil && exec.Federation.Resolver.HasResolvers() {
+		build.Objects = append(build.Objects, exec.Federation.Resolver)
+	}
+
+	for _, def := range cfg.schema.Types {
+		for _, field := range def.Fields {
+			dir := field.Directives.ForName("mock")
+			if dir == nil {
+				continue
+			}
+			value, err := directiveArg(dir, "value")
+			if err != nil {
+				return nil, err
+			}
+			leaf := cfg.schema.Types[field.Type.Name()]
+			if leaf.Kind != ast.Scalar && leaf.Kind != ast.Enum || cfg.Models[leaf.Name].Model == "map[string]interface{}" {
+				return nil, errors.Errorf("@mock on %s.%s, only scalar and enum fields can have a mock value", def.Name, field.Name)
+			}
+			build.values[def.Name+"."+field.Name], _ = value.(string)
+		}
+	}
+
+	return build, nil
+}
+
+// ResolverImplementation is the name of the type that implements the mock resolvers for an object
+func (b *MockBuild) ResolverImplementation(object *Object) string {
+	return "mock" + object.GQLType + "Resolver"
+}
+
+// Declaration is the resolver declaration for a field, with named results so the mock code can return early
+func (b *MockBuild) Declaration(field *Field) string {
+	result := field.Signature()
+	if field.Object.Stream {
+		result = "<-chan " + result
+	}
+	decl := field.ShortResolverDeclaration()
+	return strings.TrimSuffix(decl, fmt.Sprintf("(%s, error)", result)) + fmt.Sprintf("(res %s, err error)", result)
+}
+
+// ResolverBody returns a fake value for a resolver field, subscriptions send a single value and then close
+func (b *MockBuild) ResolverBody(field *Field) string {
+	var body string
+	if field.Object.Stream {
+		body = fmt.Sprintf("var value %s\n%s\nch := make(chan %s, 1)\nch <- value\nclose(ch)\nreturn ch, nil",
+			field.Signature(), b.value(field, "value", "1"), field.Signature())
+	} else {
+		body = b.value(field, "res", "1") + "\nreturn"
+	}
+
+	if strings.Contains(body, "rnd") {
+		body = fmt.Sprintf("rnd := graphql.MockRand(ctx, r.Seed)\n%s", body)
+	}
+	return body
+}
+
+// ModelFields fills in the fields of a model that are bound to struct fields
+func (b *MockBuild) ModelFields(object *Object) string {
+	var code []string
+	for i := range object.Fields {
+		field := &object.Fields[i]
+		if field.GoFieldType != GoFieldVariable || field.IsResolver() {
+			continue
+		}
+		if value := b.value(field, "res."+field.GoFieldName, "depth+1"); value != "" {
+			code = append(code, value)
+		}
+	}
+	return strings.Join(code, "\n")
+}
+
+func (b *MockBuild) value(field *Field, result string, depth string) string {
+	value, hasValue := b.values[field.Object.GQLType+"."+field.GQLName]
+	m := mockValue{
+		build:    b,
+		depth:    depth,
+		listSize: b.cfg.Mock.listSize(field.Object.GQLType, field.GQLName),
+	}
+	if hasValue {
+		m.value = &value
+	}
+	return m.generate(field.Type, result, field.Type.Modifiers, 1)
+}
+
+type mockValue struct {
+	build    *MockBuild
+	depth    string  // the go expression for how deep the value is in mocked structs
+	listSize int     // the number of items in each list
+	value    *string // the @mock(value:) on the field
+}
+
+// generate writes the code that sets result to a fake value of a type, it is empty if the zero value is used
+func (m mockValue) generate(t *Type, result string, remainingMods []string, n int) string {
+	switch {
+	case len(remainingMods) > 0 && remainingMods[0] == modPtr:
+		ptr := "ptr" + strconv.Itoa(n)
+		next := m.generate(t, ptr, remainingMods[1:], n+1)
+		if next == "" {
+			return ""
+		}
+		return tpl(`{
+			var {{.ptr}} {{.type}}
+			{{.next}}
+			{{.result}} = &{{.ptr}}
+		}`, map[string]interface{}{
+			"ptr":    ptr,
+			"type":   strings.Join(remainingMods[1:], "") + t.FullName(),
+			"next":   next,
+			"result": result,
+		})
+
+	case len(remainingMods) > 0 && remainingMods[0] == modList:
+		index := "idx" + strconv.Itoa(n)
+		return tpl(`{{.result}} = make({{.type}}, {{.size}})
+			{{- with .next }}
+			for {{$.index}} := range {{$.result}} {
+				{{.}}
+			}
+			{{- end }}`, map[string]interface{}{
+			"result": result,
+			"type":   strings.Join(remainingMods, "") + t.FullName(),
+			"size":   m.listSize,
+			"index":  index,
+			"next":   m.generate(t, result+"["+index+"]", remainingMods[1:], n+1),
+		})
+	}
+
+	def := m.build.cfg.schema.Types[t.GQLType]
+	var raw string
+	switch {
+	case m.value != nil:
+		raw = fmt.Sprintf("var raw interface{} = %s", strconv.Quote(*m.value))
+
+	case def.Kind == ast.Enum:
+		var values []string
+		for _, value := range def.EnumValues {
+			values = append(values, strconv.Quote(value.Name))
+		}
+		raw = fmt.Sprintf("raw := graphql.MockEnum(rnd, %s)", strings.Join(values, ", "))
+
+	case def.Kind == ast.Scalar:
+		if !graphql.IsMockScalar(def.Name) {
+			return ""
+		}
+		raw = fmt.Sprintf("raw := graphql.MockScalar(rnd, %s)", strconv.Quote(def.Name))
+
+	case def.Kind == ast.Interface || def.Kind == ast.Union:
+		intf := m.build.interfaces[def.Name]
+		if intf == nil || len(intf.Implementors) == 0 {
+			return ""
+		}
+		return tpl(`switch rnd.Intn({{len .intf.Implementors}}) {
+			{{- range $i, $implementor := .intf.Implementors }}
+			case {{$i}}:
+				var v {{$implementor.FullName}}
+				if v, err = r.mock{{$implementor.GQLType}}(rnd, {{$.depth}}); err != nil {
+					return
+				}
+				{{$.result}} = {{ if not $implementor.ValueReceiver }}&{{ end }}v
+			{{- end }}
+			}`, map[string]interface{}{
+			"intf":   intf,
+			"depth":  m.depth,
+			"result": result,
+		})
+
+	default:
+		return fmt.Sprintf("if %s, err = r.mock%s(rnd, %s); err != nil {\n\treturn\n}", result, t.GQLType, m.depth)
+	}
+
+	return tpl(`{
+		{{.raw}}
+		{{.unmarshal}}
+		if err != nil {
+			return
+		}
+	}`, map[string]interface{}{
+		"raw":       raw,
+		"unmarshal": t.unmarshal(result, "raw", nil, n),
+	})
+}
+
+type mockPlugin struct{}
+
+func (mockPlugin) Name() string {
+	return "mock"
+}
+
+func (mockPlugin) GenerateCode(cfg *Config, build *Build) error {
+	if !cfg.Mock.IsDefined() {
+		return nil
+	}
+
+	mockBuild, err := cfg.mock(build)
+	if err != nil {
+		return errors.Wrap(err, "mock build failed")
+	}
+
+	return templates.RenderToFile("mock.gotpl", cfg.Mock.Filename, mockBuild)
+}
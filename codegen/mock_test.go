@@ -0,0 +1,76 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestMock(t *testing.T) {
+	err := Generate(Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			type Query {
+				me: User!
+				users(first: Int): [User!]!
+				search(text: String!): [SearchResult!]!
+				node: Node
+			}
+			type Subscription { userJoined: User! }
+			interface Node { id: ID! }
+			union SearchResult = User | Post
+			enum Role { ADMIN, MEMBER }
+			type User implements Node {
+				id: ID!
+				name: String! @mock(value: "Leeroy")
+				role: Role!
+				age: Int
+				joined: Time!
+				tags: [[String!]]
+				friends: [User!]!
+			}
+			type Post implements Node { id: ID!, title: String!, author: User }
+			scalar Time
+		`},
+		Exec:  PackageConfig{Filename: "gen/mock/exec.go"},
+		Model: ModelConfig{PackageConfig: PackageConfig{Filename: "gen/mock/model.go"}},
+		Mock: MockConfig{
+			PackageConfig: PackageConfig{Filename: "gen/mock/mock/mock.go"},
+			ListSize:      2,
+			Lists:         map[string]int{"User.friends": 5},
+		},
+	})
+	require.NoError(t, err)
+
+	mock, err := ioutil.ReadFile("gen/mock/mock/mock.go")
+	require.NoError(t, err)
+	require.Contains(t, string(mock), "type MockResolver struct {\n\tSeed int64\n}")
+	require.Contains(t, string(mock), "var _ mock.ResolverRoot = &MockResolver{}")
+	require.Contains(t, string(mock), "func (r *MockResolver) Subscription() mock.SubscriptionResolver {")
+	require.Contains(t, string(mock), "func (r *mockQueryResolver) Users(ctx context.Context, first *int) (res []mock.User, err error) {")
+	require.Contains(t, string(mock), "res = make([]mock.User, 2)")
+	require.Contains(t, string(mock), "res.Friends = make([]mock.User, 5)")
+	require.Contains(t, string(mock), `var raw interface{} = "Leeroy"`)
+	require.Contains(t, string(mock), `raw := graphql.MockEnum(rnd, "ADMIN", "MEMBER")`)
+	require.Contains(t, string(mock), "func (r *MockResolver) mockPost(rnd *rand.Rand, depth int) (res mock.Post, err error) {")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/mock", "./gen/mock/mock")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+
+	t.Run("mock values can only be set on scalars and enums", func(t *testing.T) {
+		err := Generate(Config{
+			SchemaFilename: SchemaFilenames{"schema.graphql"},
+			SchemaStr: map[string]string{"schema.graphql": `
+				type Query { me: User! @mock(value: "me") }
+				type User { id: ID! }
+			`},
+			Exec:  PackageConfig{Filename: "gen/mock/exec.go"},
+			Model: ModelConfig{PackageConfig: PackageConfig{Filename: "gen/mock/model.go"}},
+			Mock:  MockConfig{PackageConfig: PackageConfig{Filename: "gen/mock/mock/mock.go"}},
+		})
+		require.EqualError(t, err, "generating mock failed: mock build failed: @mock on Query.me, only scalar and enum fields can have a mock value")
+	})
+}
@@ -5,6 +5,8 @@ type Model struct {
 	Description string
 	Fields      []ModelField
 	Implements  []*NamedType
+	HasIsSet    bool
+	Getters     []ModelGetter
 }
 
 type ModelField struct {
@@ -14,4 +16,14 @@ type ModelField struct {
 	GoFKName    string
 	GoFKType    string
 	Description string
+	Tags        string // The struct tags, without the enclosing backquotes
+}
+
+// ModelGetter is a method that returns the value of an interface field, it is declared on the interface and
+// implemented by each of its models
+type ModelGetter struct {
+	*Type
+	GoName      string
+	GoFieldName string // The field returned by the implementation, empty on the interface itself
+	Description string
 }
@@ -2,12 +2,16 @@ package codegen
 
 import (
 	"sort"
+	"strconv"
+	"strings"
+	"unicode"
 
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/ast"
-	"golang.org/x/tools/go/loader"
 )
 
-func (cfg *Config) buildModels(types NamedTypes, prog *loader.Program) ([]Model, error) {
+func (cfg *Config) buildModels(types NamedTypes, pkgs *pkgLoader) ([]Model, error) {
 	var models []Model
 
 	for _, typ := range cfg.schema.Types {
@@ -21,7 +25,10 @@ func (cfg *Config) buildModels(types NamedTypes, prog *loader.Program) ([]Model,
 			if obj.Root || obj.IsUserDefined {
 				continue
 			}
-			model = cfg.obj2Model(obj)
+			model, err = cfg.obj2Model(obj, typ)
+			if err != nil {
+				return nil, err
+			}
 		case ast.InputObject:
 			obj, err := cfg.buildInput(types, typ)
 			if err != nil {
@@ -30,9 +37,12 @@ func (cfg *Config) buildModels(types NamedTypes, prog *loader.Program) ([]Model,
 			if obj.IsUserDefined {
 				continue
 			}
-			model = cfg.obj2Model(obj)
+			model, err = cfg.obj2Model(obj, typ)
+			if err != nil {
+				return nil, err
+			}
 		case ast.Interface, ast.Union:
-			intf := cfg.buildInterface(types, typ, prog)
+			intf := cfg.buildInterface(types, typ, pkgs)
 			if intf.IsUserDefined {
 				continue
 			}
@@ -45,6 +55,8 @@ func (cfg *Config) buildModels(types NamedTypes, prog *loader.Program) ([]Model,
 		models = append(models, model)
 	}
 
+	cfg.addInterfaceGetters(models)
+
 	sort.Slice(models, func(i, j int) bool {
 		return models[i].GQLType < models[j].GQLType
 	})
@@ -52,11 +64,12 @@ func (cfg *Config) buildModels(types NamedTypes, prog *loader.Program) ([]Model,
 	return models, nil
 }
 
-func (cfg *Config) obj2Model(obj *Object) Model {
+func (cfg *Config) obj2Model(obj *Object, def *ast.Definition) (Model, error) {
 	model := Model{
 		NamedType:  obj.NamedType,
 		Implements: obj.Implements,
 		Fields:     []ModelField{},
+		HasIsSet:   obj.HasIsSet,
 	}
 
 	model.GoType = ucFirst(obj.GQLType)
@@ -72,10 +85,209 @@ func (cfg *Config) obj2Model(obj *Object) Model {
 			mf.GoFieldName = field.GoNameExported()
 		}
 
+		if cfg.Model.NullableScalars == "value" && field.IsScalar && len(field.Modifiers) == 1 && field.IsPtr() {
+			typ := *field.Type
+			typ.Modifiers = nil
+			mf.Type = &typ
+		}
+
+		var err error
+		mf.Tags, err = cfg.Model.fieldTags(mf, def.Fields.ForName(field.GQLName))
+		if err != nil {
+			return model, errors.Wrapf(err, "%s.%s", obj.GQLType, field.GQLName)
+		}
+
 		model.Fields = append(model.Fields, mf)
 	}
 
-	return model
+	return model, nil
+}
+
+const (
+	tagNameGraphql = "graphql"
+	tagNameGo      = "go"
+	tagNameSnake   = "snake"
+	tagNameCamel   = "camel"
+)
+
+// fieldTags builds the struct tags for a generated field from the configured tags, then any @goTag directives on
+// the field definition
+func (c *ModelConfig) fieldTags(field ModelField, def *ast.FieldDefinition) (string, error) {
+	tags := c.Tags
+	if !c.hasTag("json") {
+		tags = append([]ModelTag{{Key: "json"}}, tags...)
+	}
+
+	var keys []string
+	values := map[string]string{}
+	for _, tag := range tags {
+		value := tagName(tag.Name, field)
+		if tag.OmitEmpty {
+			value += ",omitempty"
+		}
+		keys = append(keys, tag.Key)
+		values[tag.Key] = value
+	}
+
+	if def != nil {
+		for _, dir := range def.Directives {
+			if dir.Name != "goTag" {
+				continue
+			}
+			key, value, err := goTagArgs(dir)
+			if err != nil {
+				return "", err
+			}
+			if value == nil {
+				value = &field.GQLName
+			}
+			if _, exists := values[key]; !exists {
+				keys = append(keys, key)
+			}
+			values[key] = *value
+		}
+	}
+
+	var parts []string
+	for _, key := range keys {
+		parts = append(parts, key+":"+strconv.Quote(values[key]))
+	}
+	return strings.Join(parts, " "), nil
+}
+
+func (c *ModelConfig) hasTag(key string) bool {
+	for _, tag := range c.Tags {
+		if tag.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func goTagArgs(dir *ast.Directive) (string, *string, error) {
+	var key string
+	var value *string
+
+	for _, arg := range dir.Arguments {
+		v, err := arg.Value.Value(nil)
+		if err != nil {
+			return "", nil, err
+		}
+		str, _ := v.(string)
+		switch arg.Name {
+		case "key":
+			key = str
+		case "value":
+			if v != nil {
+				value = &str
+			}
+		}
+	}
+
+	if key == "" || strings.ContainsAny(key, " :\"`") {
+		return "", nil, errors.Errorf("@goTag has an invalid key \"%s\"", key)
+	}
+	return key, value, nil
+}
+
+func tagName(name string, field ModelField) string {
+	switch name {
+	case tagNameGo:
+		return field.GoFieldName
+	case tagNameSnake:
+		return toSnake(field.GQLName)
+	case tagNameCamel:
+		return lcFirst(templates.ToCamel(field.GQLName))
+	default:
+		return field.GQLName
+	}
+}
+
+// toSnake converts a name like userID or UserId to user_id
+func toSnake(s string) string {
+	runes := []rune(s)
+	var buf []rune
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if (prevLower || nextLower && unicode.IsUpper(runes[i-1])) && runes[i-1] != '_' {
+				buf = append(buf, '_')
+			}
+		}
+		buf = append(buf, unicode.ToLower(r))
+	}
+	return string(buf)
+}
+
+// addInterfaceGetters adds a getter for each interface field to the generated models that implement it. Generated
+// interfaces only declare the getters that every implementor has, so bound implementors don't need to be changed.
+func (cfg *Config) addInterfaceGetters(models []Model) {
+	byName := map[string]*Model{}
+	for i := range models {
+		byName[models[i].GQLType] = &models[i]
+	}
+
+	var names []string
+	for name, def := range cfg.schema.Types {
+		if def.Kind == ast.Interface {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def := cfg.schema.Types[name]
+		intf := byName[def.Name]
+		implementors := cfg.schema.GetPossibleTypes(def)
+
+		for _, field := range def.Fields {
+			if len(field.Arguments) > 0 {
+				continue
+			}
+			getter := "Get" + lintName(ucFirst(field.Name))
+
+			var typ *Type
+			everyImplementor := len(implementors) > 0
+			for _, implementor := range implementors {
+				model := byName[implementor.Name]
+				var mf *ModelField
+				if model != nil {
+					mf = model.field(field.Name)
+				}
+				// the getter must return the field as is, so a narrower type on the implementor rules it out
+				if mf == nil || mf.GoFieldName == "" || mf.ASTType.String() != field.Type.String() {
+					everyImplementor = false
+					continue
+				}
+				typ = mf.Type
+				model.addGetter(ModelGetter{Type: mf.Type, GoName: getter, GoFieldName: mf.GoFieldName})
+			}
+
+			if intf != nil && everyImplementor {
+				intf.addGetter(ModelGetter{Type: typ, GoName: getter, Description: field.Description})
+			}
+		}
+	}
+}
+
+func (m *Model) field(gqlName string) *ModelField {
+	for i := range m.Fields {
+		if m.Fields[i].GQLName == gqlName {
+			return &m.Fields[i]
+		}
+	}
+	return nil
+}
+
+// addGetter adds a getter unless the model already has it from another interface
+func (m *Model) addGetter(getter ModelGetter) {
+	for _, existing := range m.Getters {
+		if existing.GoName == getter.GoName {
+			return
+		}
+	}
+	m.Getters = append(m.Getters, getter)
 }
 
 func int2Model(obj *Interface) Model {
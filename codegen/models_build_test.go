@@ -0,0 +1,72 @@
+package codegen
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestToSnake(t *testing.T) {
+	require.Equal(t, "user_id", toSnake("userId"))
+	require.Equal(t, "user_id", toSnake("userID"))
+	require.Equal(t, "user_id", toSnake("UserID"))
+	require.Equal(t, "id", toSnake("id"))
+	require.Equal(t, "http_server", toSnake("HTTPServer"))
+	require.Equal(t, "first_name", toSnake("first_name"))
+	require.Equal(t, "address2_line", toSnake("address2Line"))
+}
+
+func TestModelTags(t *testing.T) {
+	cfg := Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			directive @goTag(key: String!, value: String) on INPUT_FIELD_DEFINITION | FIELD_DEFINITION
+
+			type Query { user(input: UserInput!): User }
+			type User {
+				userId: ID!
+				name: String
+				password: String @goTag(key: "json", value: "-") @goTag(key: "yaml")
+			}
+			input UserInput { userId: ID }
+		`},
+		Exec: PackageConfig{Filename: "gen/modeltags/exec.go"},
+		Model: ModelConfig{
+			PackageConfig: PackageConfig{Filename: "gen/modeltags/model.go"},
+			Tags: []ModelTag{
+				{Key: "db", Name: "snake"},
+				{Key: "bson", Name: "go", OmitEmpty: true},
+			},
+			NullableScalars: "value",
+		},
+	}
+	require.NoError(t, cfg.Check())
+
+	err := Generate(cfg)
+	require.NoError(t, err)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/modeltags")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+
+	fields := func(name string) map[string]string {
+		st := pkgs[0].Types.Scope().Lookup(name).Type().Underlying().(*types.Struct)
+		res := map[string]string{}
+		for i := 0; i < st.NumFields(); i++ {
+			res[st.Field(i).Name()] = st.Field(i).Type().String() + " " + st.Tag(i)
+		}
+		return res
+	}
+
+	require.Equal(t, map[string]string{
+		"UserID":   `string json:"userId" db:"user_id" bson:"UserID,omitempty"`,
+		"Name":     `string json:"name" db:"name" bson:"Name,omitempty"`,
+		"Password": `string json:"-" db:"password" bson:"Password,omitempty" yaml:"password"`,
+	}, fields("User"))
+
+	require.Equal(t, map[string]string{
+		"UserID": `string json:"userId" db:"user_id" bson:"UserID,omitempty"`,
+	}, fields("UserInput"))
+}
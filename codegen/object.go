@@ -29,6 +29,7 @@ type Object struct {
 	Root               bool
 	DisableConcurrency bool
 	Stream             bool
+	HasIsSet           bool // For inputs, should the fields that were given be recorded in the IsSet map
 }
 
 type Field struct {
@@ -44,15 +45,25 @@ type Field struct {
 	NoErr            bool            // If this is bound to a go method, does that method have an error as the second argument
 	Object           *Object         // A link back to the parent object
 	Default          interface{}     // The default value
+	ArgsStruct       *Ref            // The generated struct the args are decoded into, when typed args are enabled
+	Constraint       *Constraint     // For input fields, the @constraint checked once the value has been unmarshaled
+	Directives       []string        // For input fields, the directives run once the input has been unmarshaled
 }
 
 type FieldArgument struct {
 	*Type
 
-	GQLName   string      // The name of the argument in graphql
-	GoVarName string      // The name of the var in go
-	Object    *Object     // A link back to the parent object
-	Default   interface{} // The default value
+	GQLName    string      // The name of the argument in graphql
+	GoVarName  string      // The name of the var in go
+	Object     *Object     // A link back to the parent object
+	Default    interface{} // The default value
+	Constraint *Constraint // The @constraint checked once the value has been unmarshaled
+	Directives []string    // The directives run once the args have been unmarshaled
+}
+
+// StructField is the name of the field that holds this argument in a generated args struct
+func (a *FieldArgument) StructField() string {
+	return lintName(ucFirst(a.GQLName))
 }
 
 type Objects []*Object
@@ -87,6 +98,75 @@ func (o *Object) IsReserved() bool {
 	return strings.HasPrefix(o.GQLType, "__")
 }
 
+// ResolverImplementation is the name of the type that implements this objects resolvers in the resolver stubs
+func (o *Object) ResolverImplementation() string {
+	return lcFirst(o.GQLType) + "Resolver"
+}
+
+// HasArgDirectives checks if there are directives to run on any of the args, including inside input args
+func (f *Field) HasArgDirectives() bool {
+	for _, arg := range f.Args {
+		if len(arg.Directives) > 0 || arg.HasInputDirectives {
+			return true
+		}
+	}
+	return false
+}
+
+// ArgDirectivesFunc is the name of the method that runs the directives on the args once they have been unmarshaled
+func (f *Field) ArgDirectivesFunc() string {
+	return f.ArgsFunc() + "_directives"
+}
+
+// ArgDirectives is the code that runs the directives on an arg, args is either the args map or typed args struct
+func (f *Field) ArgDirectives(arg *FieldArgument) string {
+	directives := fmt.Sprintf("parsedSchema.Types[%q].Fields.ForName(%q).Arguments.ForName(%q).Directives", f.Object.GQLType, f.GQLName, arg.GQLName)
+	name := f.Object.GQLType + "." + f.GQLName + "(" + arg.GQLName + ")"
+
+	var code []string
+	if f.ArgsStruct != nil {
+		value := "args." + arg.StructField()
+		if arg.HasInputDirectives {
+			code = append(code, arg.InputDirectives(value))
+		}
+		if len(arg.Directives) > 0 {
+			code = append(code, arg.DirectiveValue(value, "args", directives, "ast.LocationArgumentDefinition", name))
+		}
+		return strings.Join(code, "\n")
+	}
+
+	value := fmt.Sprintf("args[%q]", arg.GQLName)
+	if arg.HasInputDirectives {
+		// map values aren't addressable, so the input is copied out and back
+		code = append(code, tpl(`if value, ok := {{.value}}.({{.signature}}); ok {
+				{{.directives}}
+				{{.value}} = value
+			}`, map[string]interface{}{
+			"value":      value,
+			"signature":  arg.Signature(),
+			"directives": arg.InputDirectives("value"),
+		}))
+	}
+	if len(arg.Directives) > 0 {
+		code = append(code, arg.DirectiveValue(value, "args", directives, "ast.LocationArgumentDefinition", name))
+	}
+	return strings.Join(code, "\n")
+}
+
+// FieldDirectives is the code that runs the directives on an input field, it is the value of it
+func (f *Field) FieldDirectives() string {
+	value := "it." + f.GoFieldName
+	var code []string
+	if f.HasInputDirectives {
+		code = append(code, f.InputDirectives(value))
+	}
+	if len(f.Directives) > 0 {
+		directives := fmt.Sprintf("parsedSchema.Types[%q].Fields.ForName(%q).Directives", f.Object.GQLType, f.GQLName)
+		code = append(code, f.DirectiveValue(value, "it", directives, "ast.LocationInputFieldDefinition", f.Object.GQLType+"."+f.GQLName))
+	}
+	return strings.Join(code, "\n")
+}
+
 func (f *Field) IsResolver() bool {
 	return f.GoFieldName == ""
 }
@@ -151,9 +231,7 @@ func (f *Field) ShortResolverDeclaration() string {
 	if !f.Object.Root {
 		res += fmt.Sprintf(", obj *%s", f.Object.FullName())
 	}
-	for _, arg := range f.Args {
-		res += fmt.Sprintf(", %s %s", arg.GoVarName, arg.Signature())
-	}
+	res += f.argsDeclaration()
 
 	result := f.Signature()
 	if f.Object.Stream {
@@ -173,9 +251,7 @@ func (f *Field) ResolverDeclaration() string {
 	if !f.Object.Root {
 		res += fmt.Sprintf(", obj *%s", f.Object.FullName())
 	}
-	for _, arg := range f.Args {
-		res += fmt.Sprintf(", %s %s", arg.GoVarName, arg.Signature())
-	}
+	res += f.argsDeclaration()
 
 	result := f.Signature()
 	if f.Object.Stream {
@@ -188,14 +264,29 @@ func (f *Field) ResolverDeclaration() string {
 
 func (f *Field) ComplexitySignature() string {
 	res := fmt.Sprintf("func(childComplexity int")
+	res += f.argsDeclaration()
+	res += ") int"
+	return res
+}
+
+// argsDeclaration declares the args in a function signature, either one by one or as the generated args struct
+func (f *Field) argsDeclaration() string {
+	if f.ArgsStruct != nil {
+		return ", args " + f.ArgsStruct.FullName()
+	}
+
+	res := ""
 	for _, arg := range f.Args {
 		res += fmt.Sprintf(", %s %s", arg.GoVarName, arg.Signature())
 	}
-	res += ") int"
 	return res
 }
 
 func (f *Field) ComplexityArgs() string {
+	if f.ArgsStruct != nil {
+		return "args"
+	}
+
 	var args []string
 	for _, arg := range f.Args {
 		args = append(args, "args["+strconv.Quote(arg.GQLName)+"].("+arg.Signature()+")")
@@ -219,8 +310,16 @@ func (f *Field) CallArgs() string {
 		}
 	}
 
+	if f.ArgsStruct != nil && f.IsResolver() {
+		return strings.Join(append(args, "args"), ", ")
+	}
+
 	for _, arg := range f.Args {
-		args = append(args, "args["+strconv.Quote(arg.GQLName)+"].("+arg.Signature()+")")
+		if f.ArgsStruct != nil {
+			args = append(args, "args."+arg.StructField())
+		} else {
+			args = append(args, "args["+strconv.Quote(arg.GQLName)+"].("+arg.Signature()+")")
+		}
 	}
 
 	return strings.Join(args, ", ")
@@ -288,7 +387,7 @@ func (f *Field) doWriteJson(val string, remainingMods []string, astType *ast.Typ
 					if isLen1 {
 						f({{.index}})
 					} else {
-						go f({{.index}})
+						ec.Go(func() { f({{.index}}) })
 					}
 				{{ else }}
 					{{.arr}}[{{.index}}] = func() graphql.Marshaler {
@@ -312,6 +411,18 @@ func (f *Field) doWriteJson(val string, remainingMods []string, astType *ast.Typ
 		if isPtr {
 			val = "*" + val
 		}
+		if f.IsBoundEnum {
+			return tpl(`
+				if m := {{.marshal}}; m != graphql.Null {
+					return m
+				}
+				ec.Errorf(ctx, "%v is not a valid {{.type}}", {{.val}})
+				return graphql.Null`, map[string]interface{}{
+				"marshal": f.marshalValue(val),
+				"type":    f.GQLType,
+				"val":     val,
+			})
+		}
 		return f.Marshal(val)
 
 	default:
@@ -358,6 +469,16 @@ func ucFirst(s string) string {
 	return string(r)
 }
 
+func lcFirst(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
 // copy from https://github.com/golang/lint/blob/06c8688daad7faa9da5a0c2f163a3d14aac986ca/lint.go#L679
 
 // lintName returns a different name if it should be different.
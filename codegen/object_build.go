@@ -6,10 +6,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/ast"
-	"golang.org/x/tools/go/loader"
 )
 
-func (cfg *Config) buildObjects(types NamedTypes, prog *loader.Program) (Objects, error) {
+func (cfg *Config) buildObjects(types NamedTypes, pkgs *pkgLoader) (Objects, error) {
 	var objects Objects
 
 	for _, typ := range cfg.schema.Types {
@@ -22,7 +21,7 @@ func (cfg *Config) buildObjects(types NamedTypes, prog *loader.Program) (Objects
 			return nil, err
 		}
 
-		def, err := findGoType(prog, obj.Package, obj.GoType)
+		def, err := findGoType(pkgs, obj.Package, obj.GoType)
 		if err != nil {
 			return nil, err
 		}
@@ -85,7 +84,7 @@ func (cfg *Config) buildObject(types NamedTypes, typ *ast.Definition) (*Object,
 	obj := &Object{NamedType: types[typ.Name]}
 	typeEntry, entryExists := cfg.Models[typ.Name]
 
-	obj.ResolverInterface = &Ref{GoType: obj.GQLType + "Resolver"}
+	obj.ResolverInterface = &Ref{GoType: obj.GQLType + "Resolver", Package: cfg.Exec.ImportPath()}
 
 	if typ == cfg.schema.Query {
 		obj.Root = true
@@ -157,8 +156,14 @@ func (cfg *Config) buildObject(types NamedTypes, typ *ast.Definition) (*Object,
 				return nil, errors.Errorf("%s cannot be used as argument of %s.%s. only input and scalar types are allowed", arg.Type, obj.GQLType, field.Name)
 			}
 
+			var err error
+			newArg.Constraint, err = cfg.buildConstraint(arg.Directives, newArg.Type, "constraint_"+typ.Name+"_"+field.Name+"_"+arg.Name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "@constraint on %s.%s(%s)", typ.Name, field.Name, arg.Name)
+			}
+			newArg.Directives = cfg.executedDirectives(arg.Directives)
+
 			if arg.DefaultValue != nil {
-				var err error
 				newArg.Default, err = arg.DefaultValue.Value(nil)
 				if err != nil {
 					return nil, errors.Errorf("default value for %s.%s is not valid: %s", typ.Name, field.Name, err.Error())
@@ -167,14 +172,18 @@ func (cfg *Config) buildObject(types NamedTypes, typ *ast.Definition) (*Object,
 			args = append(args, newArg)
 		}
 
-		obj.Fields = append(obj.Fields, Field{
+		newField := Field{
 			GQLName:       field.Name,
 			Type:          types.getType(field.Type),
 			Args:          args,
 			Object:        obj,
 			GoFieldName:   goName,
 			ForceResolver: forceResolver,
-		})
+		}
+		if cfg.TypedArgs && len(args) > 0 && !typ.BuiltIn {
+			newField.ArgsStruct = &Ref{GoType: ucFirst(obj.GQLType) + newField.GoNameExported() + "Args", Package: cfg.Exec.ImportPath()}
+		}
+		obj.Fields = append(obj.Fields, newField)
 	}
 
 	return obj, nil
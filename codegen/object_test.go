@@ -0,0 +1,55 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestTypedArgs(t *testing.T) {
+	_ = syscall.Unlink("gen/typedargs/resolver/resolver.go")
+
+	err := Generate(Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			directive @trim on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+			type Query {
+				user(id: Int!, filter: UserFilter, tags: [String!] = ["a"] @trim): User
+				viewer: User
+			}
+			type User { id: Int!, friends(first: Int): [User!]! @goField(forceResolver: true) }
+			input UserFilter { name: String @trim }
+		`},
+		Exec:      PackageConfig{Filename: "gen/typedargs/exec.go"},
+		Model:     ModelConfig{PackageConfig: PackageConfig{Filename: "gen/typedargs/model.go"}},
+		Resolver:  PackageConfig{Filename: "gen/typedargs/resolver/resolver.go", Type: "Resolver"},
+		TypedArgs: true,
+	})
+	require.NoError(t, err)
+
+	exec, err := ioutil.ReadFile("gen/typedargs/exec.go")
+	require.NoError(t, err)
+	require.Contains(t, string(exec), "type QueryUserArgs struct {\n\tID     int         `json:\"id\"`\n\tFilter *UserFilter `json:\"filter\"`\n\tTags   []string    `json:\"tags\"`\n}")
+	require.Contains(t, string(exec), "func field_Query_user_args(rawArgs map[string]interface{}) (QueryUserArgs, error) {")
+	require.Contains(t, string(exec), "User(ctx context.Context, args QueryUserArgs) (*User, error)")
+	require.Contains(t, string(exec), "Friends(ctx context.Context, obj *User, args UserFriendsArgs) ([]User, error)")
+	require.Contains(t, string(exec), "Friends func(childComplexity int, args UserFriendsArgs) int")
+	require.Contains(t, string(exec), "TypedArgs: &args,")
+	require.Contains(t, string(exec), "func (ec *executionContext) field_Query_user_args_directives(ctx context.Context, args *QueryUserArgs) error {")
+	require.Contains(t, string(exec), "if err := ec.inputDirectivesUserFilter(ctx, args.Filter); err != nil {")
+	require.Contains(t, string(exec), "args.Tags = data")
+	require.Contains(t, string(exec), "return ec.resolvers.Query().User(rctx, args)")
+	require.NotContains(t, string(exec), "type QueryViewerArgs")
+	require.NotContains(t, string(exec), "args[\"id\"].(int)")
+
+	resolver, err := ioutil.ReadFile("gen/typedargs/resolver/resolver.go")
+	require.NoError(t, err)
+	require.Contains(t, string(resolver), "func (r *queryResolver) User(ctx context.Context, args typedargs.QueryUserArgs) (*typedargs.User, error) {")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/typedargs", "./gen/typedargs/resolver")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+}
@@ -0,0 +1,102 @@
+package codegen
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/99designs/gqlgen/internal/gopath"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/packages"
+)
+
+// packages are type checked from source so that types can still be found in packages with errors. Dependencies are
+// requested explicitly, so the imported packages always have their types.
+const pkgLoadMode = packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes |
+	packages.NeedTypesSizes | packages.NeedSyntax | packages.NeedTypesInfo
+
+// pkgLoader loads go packages and caches them, so that a single load can be shared by model generation, binding and
+// validation. Every package is loaded by the same call to go/packages, otherwise the types of a package imported by
+// two of them would not be identical. Once gqlgen rewrites a package the cache is evicted and the whole set is loaded
+// again.
+type pkgLoader struct {
+	importPaths []string
+	pkgs        map[string]*packages.Package
+}
+
+func newPkgLoader() *pkgLoader {
+	return &pkgLoader{}
+}
+
+// load the given packages along with any that were loaded before. Nothing is loaded if all of them are cached.
+func (l *pkgLoader) load(importPaths ...string) error {
+	stale := l.pkgs == nil
+	for _, importPath := range importPaths {
+		if !inStrSlice(l.importPaths, importPath) {
+			l.importPaths = append(l.importPaths, importPath)
+			stale = true
+		}
+	}
+	if !stale {
+		return nil
+	}
+
+	cwd, _ := os.Getwd()
+	patterns := make([]string, len(l.importPaths))
+	for i, importPath := range l.importPaths {
+		patterns[i] = gopath.ResolveVendor(importPath, cwd)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: pkgLoadMode}, patterns...)
+	if err != nil {
+		return errors.Wrap(err, "loading failed")
+	}
+
+	l.pkgs = map[string]*packages.Package{}
+	for _, pkg := range pkgs {
+		l.pkgs[pkg.PkgPath] = pkg
+		l.pkgs[normalizeVendor(pkg.PkgPath)] = pkg
+	}
+
+	// remember packages that could not be found, they may not have been generated yet
+	for _, importPath := range l.importPaths {
+		if _, loaded := l.pkgs[importPath]; !loaded {
+			l.pkgs[importPath] = nil
+		}
+	}
+
+	return nil
+}
+
+// get a loaded package. Returns nil if the package does not exist or was not loaded.
+func (l *pkgLoader) get(importPath string) *packages.Package {
+	return l.pkgs[importPath]
+}
+
+// evict the loaded packages after some of them have been rewritten, the next load reloads all of them
+func (l *pkgLoader) evict() {
+	l.pkgs = nil
+}
+
+// errors returns the first few errors found while loading the given packages
+func (l *pkgLoader) errors(importPaths ...string) error {
+	var errs []string
+	for _, importPath := range importPaths {
+		pkg := l.get(importPath)
+		if pkg == nil {
+			errs = append(errs, fmt.Sprintf("%s: package not found", importPath))
+			continue
+		}
+		for _, pkgErr := range pkg.Errors {
+			errs = append(errs, pkgErr.Error())
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	if len(errs) > 10 {
+		errs = append(errs[:10], fmt.Sprintf("and %d more errors", len(errs)-10))
+	}
+	return errors.New(strings.Join(errs, "\n"))
+}
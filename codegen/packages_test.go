@@ -0,0 +1,51 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPkgLoader(t *testing.T) {
+	l := newPkgLoader()
+	require.NoError(t, l.load("github.com/99designs/gqlgen/graphql", "github.com/99designs/gqlgen/handler", "github.com/99designs/gqlgen/doesnotexist"))
+
+	graphql := l.get("github.com/99designs/gqlgen/graphql")
+	require.NotNil(t, graphql.Types.Scope().Lookup("Marshaler"))
+
+	def, err := findGoType(l, "github.com/99designs/gqlgen/graphql", "MarshalString")
+	require.NoError(t, err)
+	require.Equal(t, "MarshalString", def.Name())
+
+	_, err = findGoType(l, "github.com/99designs/gqlgen/doesnotexist", "Foo")
+	require.Error(t, err)
+
+	t.Run("packages loaded together share their types", func(t *testing.T) {
+		handler := l.get("github.com/99designs/gqlgen/handler")
+		require.Equal(t, graphql.Types, handler.Imports["github.com/99designs/gqlgen/graphql"].Types)
+	})
+
+	t.Run("packages are only loaded when asked for", func(t *testing.T) {
+		require.Nil(t, l.get("github.com/99designs/gqlgen/codegen/templates"))
+
+		require.NoError(t, l.load("github.com/99designs/gqlgen/graphql"))
+		require.Equal(t, graphql, l.get("github.com/99designs/gqlgen/graphql"))
+	})
+
+	t.Run("evicting reloads every package together", func(t *testing.T) {
+		l.evict()
+		require.NoError(t, l.load("github.com/99designs/gqlgen/codegen/templates"))
+		require.NotNil(t, l.get("github.com/99designs/gqlgen/codegen/templates"))
+
+		reloaded := l.get("github.com/99designs/gqlgen/graphql")
+		require.NotEqual(t, graphql, reloaded)
+
+		handler := l.get("github.com/99designs/gqlgen/handler")
+		require.Equal(t, reloaded.Types, handler.Imports["github.com/99designs/gqlgen/graphql"].Types)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		require.NoError(t, l.errors("github.com/99designs/gqlgen/graphql"))
+		require.Error(t, l.errors("github.com/99designs/gqlgen/doesnotexist"))
+	})
+}
@@ -0,0 +1,204 @@
+package codegen
+
+import (
+	"log"
+	"os"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+)
+
+// Plugin extends code generation. Plugins implement any of the hook interfaces below, and hooks are called in the
+// order the plugins were given to GenerateWithPlugins.
+type Plugin interface {
+	Name() string
+}
+
+// ConfigMutator is called before the schema is loaded, eg to add models or schema sources
+type ConfigMutator interface {
+	MutateConfig(cfg *Config) error
+}
+
+// SchemaMutator is called once the schema has been loaded and validated, before any types are bound. Changes are
+// not reflected in the schema served by introspection.
+type SchemaMutator interface {
+	MutateSchema(schema *ast.Schema) error
+}
+
+// ModelMutator can change the models before they are generated, eg adding fields or struct tags
+type ModelMutator interface {
+	MutateModels(build *ModelBuild) error
+}
+
+// ModelGenerator writes the models that don't already exist, every schema type without a Go type must be bound
+// by updating cfg.Models once the models have been written.
+type ModelGenerator interface {
+	GenerateModels(cfg *Config, build *ModelBuild) error
+}
+
+// CodeGenerator is called with the schema bound to go types, after the models have been generated
+type CodeGenerator interface {
+	GenerateCode(cfg *Config, build *Build) error
+}
+
+// DefaultPlugins are the plugins used by gqlgen generate, they write models_gen.go, generated.go, loaders_gen.go,
+// the resolver stub, the mock resolver and the typed client.
+func DefaultPlugins() []Plugin {
+	return []Plugin{
+		modelPlugin{},
+		execPlugin{},
+		loaderPlugin{},
+		resolverPlugin{},
+		mockPlugin{},
+		clientPlugin{},
+	}
+}
+
+type modelPlugin struct{}
+
+func (modelPlugin) Name() string {
+	return "models"
+}
+
+func (modelPlugin) GenerateModels(cfg *Config, build *ModelBuild) error {
+	if len(build.Models) == 0 && len(build.Enums) == 0 {
+		return nil
+	}
+
+	if err := templates.RenderToFile("models.gotpl", cfg.Model.Filename, build); err != nil {
+		return err
+	}
+
+	for _, model := range build.Models {
+		modelCfg := cfg.Models[model.GQLType]
+		modelCfg.Model = cfg.Model.ImportPath() + "." + model.GoType
+		cfg.Models[model.GQLType] = modelCfg
+	}
+
+	for _, enum := range build.Enums {
+		modelCfg := cfg.Models[enum.GQLType]
+		modelCfg.Model = cfg.Model.ImportPath() + "." + enum.GoType
+		cfg.Models[enum.GQLType] = modelCfg
+	}
+
+	return nil
+}
+
+type execPlugin struct{}
+
+func (execPlugin) Name() string {
+	return "exec"
+}
+
+func (execPlugin) GenerateCode(cfg *Config, build *Build) error {
+	if cfg.Exec.Layout == LayoutFollowSchema {
+		return generateFollowSchemaExec(cfg, build)
+	}
+	return templates.RenderToFile("generated.gotpl", cfg.Exec.Filename, build)
+}
+
+type loaderPlugin struct{}
+
+func (loaderPlugin) Name() string {
+	return "loaders"
+}
+
+func (loaderPlugin) GenerateCode(cfg *Config, build *Build) error {
+	if len(cfg.Loaders) == 0 {
+		return nil
+	}
+
+	loaderBuild, err := cfg.loaders(build)
+	if err != nil {
+		return errors.Wrap(err, "loader build failed")
+	}
+
+	return templates.RenderToFile("loaders.gotpl", cfg.loadersFilename(), loaderBuild)
+}
+
+type resolverPlugin struct{}
+
+func (resolverPlugin) Name() string {
+	return "resolver"
+}
+
+func (resolverPlugin) GenerateCode(cfg *Config, build *Build) error {
+	if !cfg.Resolver.IsDefined() {
+		return nil
+	}
+
+	resolverBuild, err := cfg.resolver(build)
+	if err != nil {
+		return errors.Wrap(err, "resolver build failed")
+	}
+	filename := cfg.Resolver.Filename
+
+	if cfg.Resolver.Layout == LayoutFollowSchema {
+		return generateFollowSchemaResolvers(cfg, resolverBuild)
+	}
+
+	if cfg.Resolver.Layout == LayoutSingleFile {
+		if err := templates.RenderToFile("resolver.gotpl", filename, resolverBuild); err != nil {
+			return err
+		}
+		var previous []existingImport
+		if resolverBuild.existing != nil {
+			previous = resolverBuild.existing.imports
+		}
+		return mergeImports(filename, previous)
+	}
+
+	if resolverBuild.ResolverFound {
+		log.Printf("Skipped resolver: %s.%s already exists\n", cfg.Resolver.ImportPath(), cfg.Resolver.Type)
+		return nil
+	}
+
+	if _, err := os.Stat(filename); os.IsNotExist(errors.Cause(err)) {
+		if err := templates.RenderToFile("resolver.gotpl", filename, resolverBuild); err != nil {
+			return err
+		}
+	} else {
+		log.Printf("Skipped resolver: %s already exists\n", filename)
+	}
+
+	return nil
+}
+
+func generateFollowSchemaResolvers(cfg *Config, resolverBuild *ResolverBuild) error {
+	builds, err := cfg.followSchemaResolvers(resolverBuild)
+	if err != nil {
+		return err
+	}
+
+	for filename, build := range builds {
+		if build.isEmpty() {
+			if build.existing != nil {
+				if err := os.Remove(filename); err != nil {
+					return errors.Wrap(err, "unable to remove empty resolver file")
+				}
+			}
+			continue
+		}
+
+		if err := templates.RenderToFile("resolver.gotpl", filename, build); err != nil {
+			return err
+		}
+		var previous []existingImport
+		if build.existing != nil {
+			previous = build.existing.imports
+		}
+		if err := mergeImports(filename, previous); err != nil {
+			return err
+		}
+	}
+
+	// the root resolver is only written once, it is where dependencies are added
+	if _, err := os.Stat(cfg.Resolver.Filename); os.IsNotExist(errors.Cause(err)) && !resolverBuild.ResolverFound {
+		root := *resolverBuild
+		root.Objects = nil
+		return templates.RenderToFile("resolver.gotpl", cfg.Resolver.Filename, &root)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+	"golang.org/x/tools/go/packages"
+)
+
+type recordingPlugin struct {
+	calls []string
+}
+
+func (p *recordingPlugin) Name() string {
+	return "recording"
+}
+
+func (p *recordingPlugin) MutateConfig(cfg *Config) error {
+	p.calls = append(p.calls, "config")
+	cfg.SchemaStr["extra.graphql"] = `extend type Query { count: Int! }`
+	cfg.SchemaFilename = append(cfg.SchemaFilename, "extra.graphql")
+	return nil
+}
+
+func (p *recordingPlugin) MutateSchema(schema *ast.Schema) error {
+	p.calls = append(p.calls, "schema")
+	if schema.Query.Fields.ForName("count") == nil {
+		p.calls = append(p.calls, "count missing")
+	}
+	return nil
+}
+
+func (p *recordingPlugin) MutateModels(build *ModelBuild) error {
+	p.calls = append(p.calls, "models")
+	for i := range build.Models {
+		build.Models[i].Fields = append(build.Models[i].Fields, ModelField{
+			GQLName:     "-",
+			GoFieldName: "Internal",
+			Type:        &Type{NamedType: &NamedType{Ref: Ref{GoType: "bool"}}},
+		})
+	}
+	return nil
+}
+
+func (p *recordingPlugin) GenerateCode(cfg *Config, build *Build) error {
+	p.calls = append(p.calls, "code "+build.QueryRoot.GQLType)
+	return nil
+}
+
+func TestGenerateWithPlugins(t *testing.T) {
+	cfg := Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			type Query { user: User }
+			type User { id: Int! }
+		`},
+		Exec:  PackageConfig{Filename: "gen/plugins/exec.go"},
+		Model: ModelConfig{PackageConfig: PackageConfig{Filename: "gen/plugins/model.go"}},
+	}
+
+	plugin := &recordingPlugin{}
+	err := GenerateWithPlugins(cfg, append(DefaultPlugins(), plugin))
+	require.NoError(t, err)
+	require.Equal(t, []string{"config", "schema", "models", "code Query"}, plugin.calls)
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/plugins")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+	require.NotNil(t, pkgs[0].Types.Scope().Lookup("User"))
+	require.Contains(t, pkgs[0].Types.Scope().Lookup("User").Type().Underlying().String(), "Internal bool")
+}
@@ -0,0 +1,345 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/lexer"
+	"github.com/vektah/gqlparser/parser"
+)
+
+// relayFilename is the name of the schema source that declares the relay types missing from the schema
+const relayFilename = "gqlgen_relay.graphql"
+
+const connectionSuffix = "Connection"
+
+var connectionArgs = []string{"first: Int", "after: String", "last: Int", "before: String"}
+
+// Relay is the node dispatcher, which refetches any type that implements Node by its global id
+type Relay struct {
+	Node  *Field // Query.node
+	Nodes *Field // Query.nodes
+	// Resolver is the NodeResolver, with a method that fetches each type that implements Node by its own id
+	Resolver *Object
+}
+
+// relaySchema rewrites the fields marked with @connection in each schema file, then declares the relay types that
+// the schema uses without declaring them in another source
+func (cfg *Config) relaySchema() error {
+	schemaStr := make(map[string]string, len(cfg.SchemaStr))
+	var sources []*ast.Source
+	for filename, input := range cfg.SchemaStr {
+		if filename == relayFilename {
+			continue
+		}
+		rewritten, err := rewriteConnections(&ast.Source{Name: filename, Input: input})
+		if err != nil {
+			return errors.Wrap(err, filename)
+		}
+		schemaStr[filename] = rewritten
+		sources = append(sources, &ast.Source{Name: filename, Input: rewritten})
+	}
+
+	source, err := relaySource(sources)
+	if err != nil {
+		return err
+	}
+	if source != "" {
+		schemaStr[relayFilename] = source
+	}
+
+	// the map may be shared with the caller, so it is replaced rather than changed
+	cfg.SchemaStr = schemaStr
+	return nil
+}
+
+// relaySource declares the connections, edges, PageInfo, the Node interface and the node and nodes query fields,
+// when the schema doesn't already declare them
+func relaySource(sources []*ast.Source) (string, error) {
+	doc, parseErr := parser.ParseSchemas(sources...)
+	if parseErr != nil {
+		return "", parseErr
+	}
+
+	declared := map[string]*ast.Definition{}
+	for _, def := range doc.Definitions {
+		declared[def.Name] = def
+	}
+
+	queryName := "Query"
+	for _, schema := range append(doc.Schema, doc.SchemaExtension...) {
+		for _, op := range schema.OperationTypes {
+			if op.Operation == ast.Query {
+				queryName = op.Type
+			}
+		}
+	}
+
+	connections := map[string]bool{}
+	queryFields := map[string]bool{}
+	usesPageInfo := false
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		for _, field := range def.Fields {
+			if def.Name == queryName {
+				queryFields[field.Name] = true
+			}
+
+			name := field.Type.Name()
+			if name == "PageInfo" {
+				usesPageInfo = true
+			}
+			node := strings.TrimSuffix(name, connectionSuffix)
+			if node == name || node == "" || declared[name] != nil || declared[node] == nil {
+				continue
+			}
+			connections[name] = true
+		}
+	}
+
+	var decls []string
+	if (usesPageInfo || len(connections) > 0) && declared["PageInfo"] == nil {
+		decls = append(decls, `type PageInfo {
+	hasNextPage: Boolean!
+	hasPreviousPage: Boolean!
+	startCursor: String
+	endCursor: String
+}`)
+	}
+	var connectionNames []string
+	for name := range connections {
+		connectionNames = append(connectionNames, name)
+	}
+	sort.Strings(connectionNames)
+	for _, connection := range connectionNames {
+		node := strings.TrimSuffix(connection, connectionSuffix)
+		decls = append(decls, fmt.Sprintf(`type %s {
+	edges: [%sEdge!]!
+	pageInfo: PageInfo!
+}`, connection, node))
+		if declared[node+"Edge"] == nil {
+			decls = append(decls, fmt.Sprintf(`type %sEdge {
+	cursor: String!
+	node: %s!
+}`, node, node))
+		}
+	}
+
+	if declared["Node"] == nil {
+		decls = append(decls, `interface Node {
+	id: ID!
+}`)
+	}
+	var nodeFields []string
+	if !queryFields["node"] {
+		nodeFields = append(nodeFields, "\tnode(id: ID!): Node")
+	}
+	if !queryFields["nodes"] {
+		nodeFields = append(nodeFields, "\tnodes(ids: [ID!]!): [Node]!")
+	}
+	if len(nodeFields) > 0 {
+		decls = append(decls, fmt.Sprintf("extend type %s {\n%s\n}", queryName, strings.Join(nodeFields, "\n")))
+	}
+
+	if len(decls) == 0 {
+		return "", nil
+	}
+	return strings.Join(decls, "\n\n") + "\n", nil
+}
+
+// rewriteConnections turns each field marked with @connection into a relay connection resolver, eg
+// friends: [User!]! @connection becomes
+// friends(first: Int, after: String, last: Int, before: String): UserConnection! @goField(forceResolver: true)
+func rewriteConnections(source *ast.Source) (string, error) {
+	doc, parseErr := parser.ParseSchema(source)
+	if parseErr != nil {
+		return "", parseErr
+	}
+
+	runes := []rune(source.Input)
+	var tokens []lexer.Token
+	tokenAt := map[int]int{}
+	lex := lexer.New(source)
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return "", err
+		}
+		if tok.Kind == lexer.EOF {
+			break
+		}
+		tokenAt[tok.Pos.Start] = len(tokens)
+		tokens = append(tokens, tok)
+	}
+
+	type edit struct {
+		start, end int
+		text       string
+	}
+	var edits []edit
+
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		for _, field := range def.Fields {
+			dir := field.Directives.ForName("connection")
+			if dir == nil {
+				continue
+			}
+			if def.Kind != ast.Object && def.Kind != ast.Interface {
+				return "", errors.Errorf("@connection on %s.%s, only object and interface fields can be connections", def.Name, field.Name)
+			}
+
+			i := tokenAt[field.Position.Start]
+			if tokens[i].Kind == lexer.String || tokens[i].Kind == lexer.BlockString {
+				i++
+			}
+			i++ // the field name
+
+			var missing []string
+			for _, arg := range connectionArgs {
+				if field.Arguments.ForName(arg[:strings.Index(arg, ":")]) == nil {
+					missing = append(missing, arg)
+				}
+			}
+			if tokens[i].Kind == lexer.ParenL {
+				i = skipParens(tokens, i)
+				if len(missing) > 0 {
+					edits = append(edits, edit{tokens[i-1].Pos.Start, tokens[i-1].Pos.Start, ", " + strings.Join(missing, ", ")})
+				}
+			} else {
+				edits = append(edits, edit{tokens[i].Pos.Start, tokens[i].Pos.Start, "(" + strings.Join(missing, ", ") + ")"})
+			}
+
+			typeStart := i + 1 // after the colon
+			typeEnd := skipType(tokens, typeStart)
+			edits = append(edits, edit{tokens[typeStart].Pos.Start, tokens[typeEnd-1].Pos.End, field.Type.Name() + connectionSuffix + "!"})
+
+			// connections are paginated, so they always need a resolver
+			replacement := "@goField(forceResolver: true)"
+			if field.Directives.ForName("goField") != nil {
+				replacement = ""
+			}
+			at := tokenAt[dir.Position.Start] - 1
+			end := skipParens(tokens, at+2)
+			start := tokens[at].Pos.Start
+			if replacement == "" {
+				for start > 0 && (runes[start-1] == ' ' || runes[start-1] == '\t') {
+					start--
+				}
+			}
+			edits = append(edits, edit{start, tokens[end-1].Pos.End, replacement})
+		}
+	}
+
+	if len(edits) == 0 {
+		return source.Input, nil
+	}
+
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+	var out []rune
+	last := 0
+	for _, e := range edits {
+		out = append(out, runes[last:e.start]...)
+		out = append(out, []rune(e.text)...)
+		last = e.end
+	}
+	return string(append(out, runes[last:]...)), nil
+}
+
+// skipParens returns the index after a parenthesised list starting at i, if there is one
+func skipParens(tokens []lexer.Token, i int) int {
+	if i >= len(tokens) || tokens[i].Kind != lexer.ParenL {
+		return i
+	}
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].Kind {
+		case lexer.ParenL:
+			depth++
+		case lexer.ParenR:
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+// skipType returns the index after a type reference starting at i, eg [User!]!
+func skipType(tokens []lexer.Token, i int) int {
+	if tokens[i].Kind == lexer.BracketL {
+		i = skipType(tokens, i+1) + 1
+	} else {
+		i++
+	}
+	if i < len(tokens) && tokens[i].Kind == lexer.Bang {
+		i++
+	}
+	return i
+}
+
+// buildRelay binds the node and nodes query fields to the generated dispatcher, and builds the NodeResolver
+func (cfg *Config) buildRelay(types NamedTypes, objects Objects) (*Relay, error) {
+	query := objects.ByName(cfg.schema.Query.Name)
+	relay := &Relay{
+		Resolver: &Object{
+			NamedType:         &NamedType{GQLType: "Node"},
+			ResolverInterface: &Ref{GoType: "NodeResolver", Package: cfg.Exec.ImportPath()},
+			Root:              true,
+		},
+	}
+
+	for i := range query.Fields {
+		field := &query.Fields[i]
+		switch field.GQLName {
+		case "node":
+			relay.Node = field
+		case "nodes":
+			relay.Nodes = field
+		}
+	}
+	if relay.Node == nil || relay.Node.ASTType.String() != "Node" || len(relay.Node.Args) != 1 || relay.Node.Args[0].GQLName != "id" || relay.Node.Args[0].ASTType.String() != "ID!" {
+		return nil, errors.Errorf("relay: %s.node must be declared as node(id: ID!): Node", query.GQLType)
+	}
+	if relay.Nodes == nil || relay.Nodes.ASTType.String() != "[Node]!" || len(relay.Nodes.Args) != 1 || relay.Nodes.Args[0].GQLName != "ids" || relay.Nodes.Args[0].ASTType.String() != "[ID!]!" {
+		return nil, errors.Errorf("relay: %s.nodes must be declared as nodes(ids: [ID!]!): [Node]!", query.GQLType)
+	}
+
+	relay.Node.bindDispatcher("resolveNode")
+	relay.Nodes.bindDispatcher("resolveNodes")
+
+	node := cfg.schema.Types["Node"]
+	if node.Kind != ast.Interface {
+		return nil, errors.Errorf("relay: Node must be an interface")
+	}
+	for _, impl := range cfg.schema.GetPossibleTypes(node) {
+		relay.Resolver.Fields = append(relay.Resolver.Fields, Field{
+			GQLName: impl.Name,
+			Type:    &Type{NamedType: types[impl.Name], Modifiers: []string{modPtr}, ASTType: ast.NamedType(impl.Name, nil)},
+			Args: []FieldArgument{{
+				GQLName:   "id",
+				GoVarName: "id",
+				Type:      &Type{NamedType: types["ID"], ASTType: ast.NonNullNamedType("ID", nil)},
+				Object:    relay.Resolver,
+			}},
+			Object: relay.Resolver,
+		})
+	}
+	sort.Slice(relay.Resolver.Fields, func(i, j int) bool {
+		return relay.Resolver.Fields[i].GQLName < relay.Resolver.Fields[j].GQLName
+	})
+
+	return relay, nil
+}
+
+// bindDispatcher resolves a field with a method generated on the executionContext instead of a resolver
+func (f *Field) bindDispatcher(method string) {
+	f.GoFieldType = GoFieldMethod
+	f.GoReceiverName = "ec"
+	f.GoFieldName = method
+	f.MethodHasContext = true
+}
@@ -0,0 +1,134 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestRewriteConnections(t *testing.T) {
+	rewritten, err := rewriteConnections(&ast.Source{Input: `
+		type User implements Node {
+			id: ID!
+			"the users friends"
+			friends: [User!]! @connection
+			posts(tag: String, first: Int): [Post] @deprecated @connection
+			comments: [Comment!] @goField(name: "CommentList") @connection
+			name: String
+		}`})
+	require.NoError(t, err)
+	require.Equal(t, `
+		type User implements Node {
+			id: ID!
+			"the users friends"
+			friends(first: Int, after: String, last: Int, before: String): UserConnection! @goField(forceResolver: true)
+			posts(tag: String, first: Int, after: String, last: Int, before: String): PostConnection! @deprecated @goField(forceResolver: true)
+			comments(first: Int, after: String, last: Int, before: String): CommentConnection! @goField(name: "CommentList")
+			name: String
+		}`, rewritten)
+
+	_, err = rewriteConnections(&ast.Source{Input: `input Filter { users: [User] @connection }`})
+	require.EqualError(t, err, "@connection on Filter.users, only object and interface fields can be connections")
+}
+
+func TestRelaySource(t *testing.T) {
+	source, err := relaySource([]*ast.Source{{Input: `
+		schema { query: Root }
+		type Root { users: UserConnection!, posts: PostConnection, node(id: ID!): Node }
+		type User { id: ID! }
+		type Post { id: ID! }
+		type PostConnection { edges: [Post!]! }
+		type UnknownConnection { id: ID! }
+		interface Node { id: ID! }
+	`}})
+	require.NoError(t, err)
+	require.Equal(t, `type PageInfo {
+	hasNextPage: Boolean!
+	hasPreviousPage: Boolean!
+	startCursor: String
+	endCursor: String
+}
+
+type UserConnection {
+	edges: [UserEdge!]!
+	pageInfo: PageInfo!
+}
+
+type UserEdge {
+	cursor: String!
+	node: User!
+}
+
+extend type Root {
+	nodes(ids: [ID!]!): [Node]!
+}
+`, source)
+
+	source, err = relaySource([]*ast.Source{{Input: `
+		type Query { users: UserConnection! }
+		type User { id: ID! }
+		type UserConnection { edges: [UserEdge!]!, pageInfo: PageInfo! }
+		type UserEdge { cursor: String!, node: User! }
+	`}})
+	require.NoError(t, err)
+	require.Contains(t, source, "type PageInfo {", "a connection declared in the schema still gets PageInfo")
+}
+
+func TestRelay(t *testing.T) {
+	_ = syscall.Unlink("gen/relay/resolver/resolver.go")
+
+	err := Generate(Config{
+		SchemaFilename: SchemaFilenames{"schema.graphql"},
+		SchemaStr: map[string]string{"schema.graphql": `
+			type Query { viewer: User }
+			type User implements Node { id: ID!, friends: [User!]! @connection }
+			type Todo implements Node { id: ID! }
+		`},
+		Exec:     PackageConfig{Filename: "gen/relay/exec.go"},
+		Model:    ModelConfig{PackageConfig: PackageConfig{Filename: "gen/relay/model.go"}},
+		Resolver: PackageConfig{Filename: "gen/relay/resolver/resolver.go", Type: "Resolver"},
+		Relay:    true,
+	})
+	require.NoError(t, err)
+
+	exec, err := ioutil.ReadFile("gen/relay/exec.go")
+	require.NoError(t, err)
+	require.Contains(t, string(exec), "Node() NodeResolver")
+	require.Contains(t, string(exec), "type NodeResolver interface {\n\tTodo(ctx context.Context, id string) (*Todo, error)\n\tUser(ctx context.Context, id string) (*User, error)\n}")
+	require.Contains(t, string(exec), "return ec.resolveNode(ctx, args[\"id\"].(string))")
+	require.Contains(t, string(exec), "Friends(ctx context.Context, obj *User, first *int, after *string, last *int, before *string) (UserConnection, error)")
+	require.NotContains(t, string(exec), "@connection")
+
+	model, err := ioutil.ReadFile("gen/relay/model.go")
+	require.NoError(t, err)
+	require.Contains(t, string(model), "type PageInfo struct {")
+	require.Contains(t, string(model), "type UserEdge struct {")
+
+	resolver, err := ioutil.ReadFile("gen/relay/resolver/resolver.go")
+	require.NoError(t, err)
+	require.Contains(t, string(resolver), "func (r *Resolver) Node() relay.NodeResolver {")
+	require.Contains(t, string(resolver), "func (r *nodeResolver) User(ctx context.Context, id string) (*relay.User, error) {")
+	require.NotContains(t, string(resolver), "func (r *queryResolver) Node(")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/relay", "./gen/relay/resolver")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+
+	t.Run("node must match the relay spec", func(t *testing.T) {
+		err := Generate(Config{
+			SchemaFilename: SchemaFilenames{"schema.graphql"},
+			SchemaStr: map[string]string{"schema.graphql": `
+				type Query { node(id: String!): Node }
+				type User implements Node { id: ID! }
+			`},
+			Exec:  PackageConfig{Filename: "gen/relay/exec.go"},
+			Model: ModelConfig{PackageConfig: PackageConfig{Filename: "gen/relay/model.go"}},
+			Relay: true,
+		})
+		require.EqualError(t, err, "exec plan failed: relay: Query.node must be declared as node(id: ID!): Node")
+	})
+}
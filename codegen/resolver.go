@@ -0,0 +1,347 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/internal/imports"
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/ast/astutil"
+	goimports "golang.org/x/tools/imports"
+)
+
+const (
+	// LayoutSingleFile rewrites the resolver file on every generate, keeping any code that has already been written
+	LayoutSingleFile = "single-file"
+	// LayoutFollowSchema writes the resolvers declared in each schema file to their own file, keeping any code that
+	// has already been written
+	LayoutFollowSchema = "follow-schema"
+)
+
+const (
+	defaultResolverFilename = "resolver.go"
+	defaultFilenameTemplate = "{name}.resolvers.go"
+)
+
+const unusedWarning = `// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have one last chance
+// to move it out of harms way if you want. There are two reasons this happens:
+//  - When renaming or deleting a resolver the old code will be put in here. You can safely delete it when you're done.
+//  - You have helper methods on the resolver types in this file. Move them out to keep these resolver files clean.
+`
+
+// HasObject checks if the resolver type for an object belongs in this file
+func (r *ResolverBuild) HasObject(object *Object) bool {
+	if !object.HasResolvers() {
+		return false
+	}
+	return !r.followSchema || r.declaredIn[object.GQLType] == r.schemaFile
+}
+
+// HasField checks if the resolver for a field belongs in this file
+func (r *ResolverBuild) HasField(field *Field) bool {
+	if !field.IsResolver() {
+		return false
+	}
+	return !r.followSchema || r.declaredIn[field.Object.GQLType+"."+field.GQLName] == r.schemaFile
+}
+
+// ResolverTypeDecl is the declaration of the root resolver type, keeping any fields that have been added to it
+func (r *ResolverBuild) ResolverTypeDecl() string {
+	if r.followSchema {
+		return ""
+	}
+	if r.existing != nil && r.existing.resolverType != "" {
+		return r.existing.resolverType
+	}
+	if r.declaredElsewhere[r.ResolverType] {
+		return ""
+	}
+	return fmt.Sprintf("type %s struct{}", r.ResolverType)
+}
+
+// ObjectResolverDecl is the declaration of the type that implements the resolvers for an object
+func (r *ResolverBuild) ObjectResolverDecl(object *Object) string {
+	name := object.ResolverImplementation()
+	if r.existing != nil && r.existing.resolverTypes[name] != "" {
+		return r.existing.resolverTypes[name]
+	}
+	if r.declaredElsewhere[name] {
+		return ""
+	}
+	return fmt.Sprintf("type %s struct{ *%s }", name, r.ResolverType)
+}
+
+// Implementation renders a resolver method, keeping the body of any existing implementation
+func (r *ResolverBuild) Implementation(receiverType string, method string, declaration string, defaultBody string) string {
+	key := methodKey(receiverType, method)
+	if r.declaredElsewhere[key] {
+		return ""
+	}
+
+	receiverName, doc, body := "r", "", "{\n"+defaultBody+"\n}"
+	if existing := r.implementations[key]; existing != nil {
+		doc, body = existing.doc, existing.body
+		if existing.receiverName != "" {
+			receiverName = existing.receiverName
+		}
+	}
+
+	return fmt.Sprintf("%sfunc (%s *%s) %s %s", doc, receiverName, receiverType, declaration, body)
+}
+
+// RemainingSource is any other code that was in the resolver file, eg helper functions
+func (r *ResolverBuild) RemainingSource() string {
+	if r.existing == nil {
+		return ""
+	}
+	return strings.Join(r.existing.other, "\n\n")
+}
+
+// UnusedSource is the code for resolvers that no longer exist in the schema, commented out so it can be moved
+// somewhere safe or deleted. Code that was already commented out by a previous generate is kept.
+func (r *ResolverBuild) UnusedSource() string {
+	if r.existing == nil {
+		return ""
+	}
+
+	generated := map[string]bool{}
+	for _, object := range r.Objects {
+		if !object.HasResolvers() {
+			continue
+		}
+		name := object.ResolverImplementation()
+		generated[name] = true
+		generated[methodKey(r.ResolverType, object.GQLType)] = true
+		for _, field := range object.Fields {
+			if field.IsResolver() {
+				generated[methodKey(name, field.GoNameExported())] = true
+			}
+		}
+	}
+
+	var unused []string
+
+	var typeNames []string
+	for name := range r.existing.resolverTypes {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	for _, name := range typeNames {
+		if !generated[name] {
+			unused = append(unused, r.existing.resolverTypes[name])
+		}
+	}
+
+	for _, key := range r.existing.methodOrder {
+		method := r.existing.methods[key]
+		if generated[key] {
+			continue
+		}
+		if method.getter || r.isObjectResolver(method.receiverType) {
+			unused = append(unused, method.source)
+		}
+	}
+
+	if len(unused) == 0 && r.existing.unused == "" {
+		return ""
+	}
+
+	var lines []string
+	if r.existing.unused != "" {
+		lines = append(lines, r.existing.unused)
+		if len(unused) > 0 {
+			lines = append(lines, "//")
+		}
+	}
+	if len(unused) > 0 {
+		for _, line := range strings.Split(strings.Join(unused, "\n\n"), "\n") {
+			lines = append(lines, strings.TrimRight("// "+line, " "))
+		}
+	}
+	return unusedWarning + strings.Join(lines, "\n")
+}
+
+// keepOtherMethods moves methods that gqlgen would never generate into the remaining source, so they are kept as is
+func (r *ResolverBuild) keepOtherMethods() {
+	if r.existing == nil {
+		return
+	}
+	for _, key := range r.existing.methodOrder {
+		method := r.existing.methods[key]
+		if method.getter || r.isObjectResolver(method.receiverType) {
+			continue
+		}
+		r.existing.other = append(r.existing.other, method.source)
+	}
+}
+
+// isObjectResolver checks if a type implements the resolvers for an object, either now or in the previous version
+// of the file
+func (r *ResolverBuild) isObjectResolver(typeName string) bool {
+	if r.existing != nil && r.existing.resolverTypes[typeName] != "" {
+		return true
+	}
+	for _, object := range r.Objects {
+		if object.HasResolvers() && object.ResolverImplementation() == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// followSchemaResolvers splits a resolver build into one build per schema file, keyed by the file they are written
+// to. Resolver files left over from schema files that no longer exist are included so that their code is kept.
+func (cfg *Config) followSchemaResolvers(base *ResolverBuild) (map[string]*ResolverBuild, error) {
+	declaredIn := map[string]string{}
+	for _, def := range cfg.schema.Types {
+		if def.Position != nil && def.Position.Src != nil {
+			declaredIn[def.Name] = def.Position.Src.Name
+		}
+		for _, field := range def.Fields {
+			if field.Position != nil && field.Position.Src != nil {
+				declaredIn[def.Name+"."+field.Name] = field.Position.Src.Name
+			}
+		}
+	}
+
+	// the NodeResolver goes with the query resolver, and each of its methods with the type it fetches
+	if cfg.Relay {
+		declaredIn["Node"] = declaredIn[cfg.schema.Query.Name]
+		for _, impl := range cfg.schema.GetPossibleTypes(cfg.schema.Types["Node"]) {
+			declaredIn["Node."+impl.Name] = declaredIn[impl.Name]
+		}
+	}
+	// the EntityResolver goes with the query resolver, and each of its find methods with the entity it finds
+	if cfg.Federation {
+		declaredIn["Entity"] = declaredIn[cfg.schema.Query.Name]
+		for _, object := range base.Objects {
+			if object.Root && object.GQLType == "Entity" {
+				for _, find := range object.Fields {
+					declaredIn["Entity."+find.GQLName] = declaredIn[find.GQLType]
+				}
+			}
+		}
+	}
+
+	schemaFiles := map[string]string{}
+	for _, schemaFile := range cfg.SchemaFilename {
+		schemaFiles[cfg.Resolver.followSchemaFilename(schemaFile)] = schemaFile
+	}
+
+	stale, err := filepath.Glob(cfg.Resolver.followSchemaFilename("*"))
+	if err != nil {
+		return nil, err
+	}
+
+	// a filename template like {name}.go would also match the other files gqlgen writes
+	owned := map[string]bool{
+		abs(cfg.Resolver.Filename): true,
+		abs(cfg.Exec.Filename):     true,
+		abs(cfg.Model.Filename):    true,
+	}
+
+	builds := map[string]*ResolverBuild{}
+	implementations := map[string]*existingMethod{}
+	var filenames []string
+	for _, filename := range append(stale, sortedKeys(schemaFiles)...) {
+		filename = abs(filename)
+		if builds[filename] != nil || owned[filename] {
+			continue
+		}
+
+		existing, err := parseExistingResolver(filename, cfg.Resolver.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		build := *base
+		build.existing = existing
+		build.implementations = implementations
+		build.followSchema = true
+		build.schemaFile = schemaFiles[filename]
+		build.declaredIn = declaredIn
+		build.keepOtherMethods()
+		builds[filename] = &build
+		filenames = append(filenames, filename)
+
+		if existing != nil {
+			for key, method := range existing.methods {
+				implementations[key] = method
+			}
+		}
+	}
+
+	declaredElsewhere := cfg.declaredOutside(cfg.Resolver.ImportPath(), filenames...)
+	for _, build := range builds {
+		build.declaredElsewhere = declaredElsewhere
+	}
+
+	return builds, nil
+}
+
+// isEmpty checks if there is nothing to write to a resolver file
+func (r *ResolverBuild) isEmpty() bool {
+	for _, object := range r.Objects {
+		if r.HasObject(object) {
+			return false
+		}
+		for i := range object.Fields {
+			if r.HasField(&object.Fields[i]) {
+				return false
+			}
+		}
+	}
+	return r.RemainingSource() == "" && r.UnusedSource() == ""
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mergeImports adds the imports from the previous version of a file, then removes any that are unused and adds any
+// that are missing
+func mergeImports(filename string, previous []existingImport) error {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse %s", filename)
+	}
+	for _, imp := range previous {
+		astutil.AddNamedImport(fset, file, imp.Alias, imp.Path)
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, file); err != nil {
+		return err
+	}
+
+	pruned, err := imports.Prune(filename, buf.Bytes())
+	if err != nil {
+		return errors.Wrapf(err, "unable to prune imports in %s", filename)
+	}
+
+	formatted, err := goimports.Process(filename, pruned, nil)
+	if err != nil {
+		return errors.Wrapf(err, "goimports failed on %s", filename)
+	}
+
+	return ioutil.WriteFile(filename, formatted, 0644)
+}
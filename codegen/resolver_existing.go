@@ -0,0 +1,166 @@
+package codegen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// existingResolver is the code from a resolver file written by a previous generate, so that it can be kept when the
+// file is rewritten
+type existingResolver struct {
+	imports       []existingImport
+	resolverType  string            // the declaration of the root resolver type, including any fields added to it
+	resolverTypes map[string]string // declarations of the per object resolver types, eg queryResolver
+	methods       map[string]*existingMethod
+	methodOrder   []string
+	other         []string // top level declarations that gqlgen doesn't generate, eg helper functions
+	unused        string   // code that was commented out by a previous generate
+}
+
+type existingImport struct {
+	Path  string
+	Alias string
+}
+
+type existingMethod struct {
+	receiverName string
+	receiverType string
+	doc          string
+	body         string
+	source       string
+	// getter is set for methods on the root resolver that return one of the object resolvers
+	getter bool
+}
+
+func methodKey(receiverType string, method string) string {
+	return receiverType + "." + method
+}
+
+// parseExistingResolver reads a resolver file, returning nil if it doesn't exist yet
+func parseExistingResolver(filename string, resolverType string) (*existingResolver, error) {
+	src, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "unable to read existing resolver")
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse existing resolver")
+	}
+
+	text := func(from, to token.Pos) string {
+		return string(src[fset.Position(from).Offset:fset.Position(to).Offset])
+	}
+	withDoc := func(doc *ast.CommentGroup, from, to token.Pos) string {
+		if doc != nil {
+			from = doc.Pos()
+		}
+		return text(from, to)
+	}
+
+	existing := &existingResolver{
+		resolverTypes: map[string]string{},
+		methods:       map[string]*existingMethod{},
+	}
+
+	for _, group := range file.Comments {
+		comment := text(group.Pos(), group.End())
+		if strings.HasPrefix(comment, unusedWarning) {
+			existing.unused = strings.TrimSpace(strings.TrimPrefix(comment, unusedWarning))
+		}
+	}
+
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imp := existingImport{Path: path}
+		if spec.Name != nil {
+			imp.Alias = spec.Name.Name
+		}
+		existing.imports = append(existing.imports, imp)
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				continue
+			}
+			if len(decl.Specs) == 1 && decl.Tok == token.TYPE {
+				spec := decl.Specs[0].(*ast.TypeSpec)
+				if spec.Name.Name == resolverType {
+					existing.resolverType = withDoc(decl.Doc, decl.Pos(), decl.End())
+					continue
+				}
+				if embedsResolver(spec, resolverType) {
+					existing.resolverTypes[spec.Name.Name] = withDoc(decl.Doc, decl.Pos(), decl.End())
+					continue
+				}
+			}
+			existing.other = append(existing.other, withDoc(decl.Doc, decl.Pos(), decl.End()))
+
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) != 1 {
+				existing.other = append(existing.other, withDoc(decl.Doc, decl.Pos(), decl.End()))
+				continue
+			}
+
+			recv := decl.Recv.List[0]
+			method := &existingMethod{
+				receiverType: receiverTypeName(recv.Type),
+				body:         text(decl.Body.Pos(), decl.Body.End()),
+				source:       withDoc(decl.Doc, decl.Pos(), decl.End()),
+			}
+			if len(recv.Names) > 0 {
+				method.receiverName = recv.Names[0].Name
+			}
+			if decl.Doc != nil {
+				method.doc = text(decl.Doc.Pos(), decl.Doc.End()) + "\n"
+			}
+			if method.receiverType == resolverType && decl.Type.Params.NumFields() == 0 && decl.Type.Results.NumFields() == 1 {
+				method.getter = strings.HasSuffix(receiverTypeName(decl.Type.Results.List[0].Type), "Resolver")
+			}
+
+			key := methodKey(method.receiverType, decl.Name.Name)
+			existing.methods[key] = method
+			existing.methodOrder = append(existing.methodOrder, key)
+		}
+	}
+
+	return existing, nil
+}
+
+// embedsResolver checks if a type is declared as struct{ *Resolver }, like the generated object resolvers
+func embedsResolver(spec *ast.TypeSpec, resolverType string) bool {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok || len(st.Fields.List) != 1 || len(st.Fields.List[0].Names) != 0 {
+		return false
+	}
+	star, ok := st.Fields.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == resolverType
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	}
+	return ""
+}
@@ -0,0 +1,145 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestResolverLayoutSingleFile(t *testing.T) {
+	cfg := func(schema string) Config {
+		return Config{
+			SchemaFilename: SchemaFilenames{"schema.graphql"},
+			SchemaStr:      map[string]string{"schema.graphql": schema},
+			Exec:           PackageConfig{Filename: "gen/singlefile/exec.go"},
+			Model:          ModelConfig{PackageConfig: PackageConfig{Filename: "gen/singlefile/model.go"}},
+			Resolver:       PackageConfig{Filename: "gen/singlefile/resolver.go", Type: "Resolver", Layout: LayoutSingleFile},
+		}
+	}
+	filename := "gen/singlefile/resolver.go"
+	_ = syscall.Unlink(filename)
+
+	err := Generate(cfg(`
+		type Query { user(id: Int!): User, users: [User!]! }
+		type User { id: Int!, name: String! }
+	`))
+	require.NoError(t, err)
+
+	src, err := ioutil.ReadFile(filename)
+	require.NoError(t, err)
+	require.Contains(t, string(src), "func (r *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\tpanic(\"not implemented\")\n}")
+
+	edited := strings.Replace(string(src), "type Resolver struct{}", "type Resolver struct {\n\tusers map[int]*User\n}", 1)
+	edited = strings.Replace(edited, "func (r *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\tpanic(\"not implemented\")\n}",
+		"// User looks up a single user\nfunc (q *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\treturn q.users[id], nil\n}", 1)
+	edited = strings.Replace(edited, "func (r *queryResolver) Users(ctx context.Context) ([]User, error) {\n\tpanic(\"not implemented\")\n}",
+		"func (r *queryResolver) Users(ctx context.Context) ([]User, error) {\n\treturn nil, errors.New(\"no users\")\n}", 1)
+	edited = strings.Replace(edited, "import (", "import (\n\t\"errors\"\n\t\"strconv\"", 1)
+	edited += "\nfunc userKey(id int) string {\n\treturn strconv.Itoa(id)\n}\n"
+	require.NoError(t, ioutil.WriteFile(filename, []byte(edited), 0644))
+
+	err = Generate(cfg(`
+		type Query { user(id: Int!): User, viewer: User }
+		type User { id: Int!, name: String! }
+	`))
+	require.NoError(t, err)
+
+	src, err = ioutil.ReadFile(filename)
+	require.NoError(t, err)
+	out := string(src)
+
+	require.Contains(t, out, "type Resolver struct {\n\tusers map[int]*User\n}")
+	require.Contains(t, out, "// User looks up a single user\nfunc (q *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\treturn q.users[id], nil\n}")
+	require.Contains(t, out, "func (r *queryResolver) Viewer(ctx context.Context) (*User, error) {\n\tpanic(\"not implemented\")\n}")
+	require.Contains(t, out, "func userKey(id int) string {\n\treturn strconv.Itoa(id)\n}")
+	require.Contains(t, out, "// func (r *queryResolver) Users(ctx context.Context) ([]User, error) {\n// \treturn nil, errors.New(\"no users\")\n// }")
+	require.Contains(t, out, "\"strconv\"")
+	require.NotContains(t, out, "\"errors\"")
+
+	err = Generate(cfg(`
+		type Query { user(id: Int!): User, viewer: User }
+		type User { id: Int!, name: String! }
+	`))
+	require.NoError(t, err)
+
+	src, err = ioutil.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, out, string(src), "generating again should not change anything")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/singlefile")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+}
+
+func TestResolverLayoutFollowSchema(t *testing.T) {
+	cfg := func(schema map[string]string) Config {
+		cfg := Config{
+			SchemaStr: schema,
+			Exec:      PackageConfig{Filename: "gen/followschema/exec.go"},
+			Model:     ModelConfig{PackageConfig: PackageConfig{Filename: "gen/followschema/model.go"}},
+			Resolver:  PackageConfig{DirName: "gen/followschema", Type: "Resolver", Layout: LayoutFollowSchema},
+		}
+		for name := range schema {
+			cfg.SchemaFilename = append(cfg.SchemaFilename, name)
+		}
+		return cfg
+	}
+	for _, filename := range []string{"resolver.go", "schema.resolvers.go", "user.resolvers.go"} {
+		_ = syscall.Unlink("gen/followschema/" + filename)
+	}
+
+	err := Generate(cfg(map[string]string{
+		"schema.graphql": `type Query { viewer: User }`,
+		"user.graphql": `
+			type User { id: Int!, friends: [User!]! @goField(forceResolver: true) }
+			extend type Query { user(id: Int!): User }
+		`,
+	}))
+	require.NoError(t, err)
+
+	src, err := ioutil.ReadFile("gen/followschema/resolver.go")
+	require.NoError(t, err)
+	require.Contains(t, string(src), "type Resolver struct{}")
+	require.NotContains(t, string(src), "queryResolver")
+
+	src, err = ioutil.ReadFile("gen/followschema/schema.resolvers.go")
+	require.NoError(t, err)
+	require.Contains(t, string(src), "func (r *Resolver) Query() QueryResolver {")
+	require.Contains(t, string(src), "type queryResolver struct{ *Resolver }")
+	require.Contains(t, string(src), "func (r *queryResolver) Viewer(ctx context.Context) (*User, error) {")
+	require.NotContains(t, string(src), "func (r *queryResolver) User(")
+
+	src, err = ioutil.ReadFile("gen/followschema/user.resolvers.go")
+	require.NoError(t, err)
+	require.Contains(t, string(src), "func (r *queryResolver) User(ctx context.Context, id int) (*User, error) {")
+	require.Contains(t, string(src), "func (r *userResolver) Friends(ctx context.Context, obj *User) ([]User, error) {")
+	require.NotContains(t, string(src), "type Resolver struct")
+
+	edited := strings.Replace(string(src), "func (r *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\tpanic(\"not implemented\")\n}",
+		"func (r *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\treturn &User{ID: id}, nil\n}", 1)
+	require.NoError(t, ioutil.WriteFile("gen/followschema/user.resolvers.go", []byte(edited), 0644))
+
+	// moving a field to another schema file moves its implementation too
+	err = Generate(cfg(map[string]string{
+		"schema.graphql": `type Query { viewer: User, user(id: Int!): User }`,
+		"user.graphql":   `type User { id: Int!, friends: [User!]! @goField(forceResolver: true) }`,
+	}))
+	require.NoError(t, err)
+
+	src, err = ioutil.ReadFile("gen/followschema/schema.resolvers.go")
+	require.NoError(t, err)
+	require.Contains(t, string(src), "func (r *queryResolver) User(ctx context.Context, id int) (*User, error) {\n\treturn &User{ID: id}, nil\n}")
+
+	src, err = ioutil.ReadFile("gen/followschema/user.resolvers.go")
+	require.NoError(t, err)
+	require.NotContains(t, string(src), "queryResolver")
+	require.NotContains(t, string(src), "WARNING")
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedTypes}, "./gen/followschema")
+	require.NoError(t, err)
+	require.Equal(t, 0, packages.PrintErrors(pkgs))
+}
@@ -1,13 +1,21 @@
 package templates
 
 var data = map[string]string{
-	"args.gotpl":      "\targs := map[string]interface{}{}\n\t{{- range $i, $arg := . }}\n\t\tvar arg{{$i}} {{$arg.Signature }}\n\t\tif tmp, ok := rawArgs[{{$arg.GQLName|quote}}]; ok {\n\t\t\tvar err error\n\t\t\t{{$arg.Unmarshal (print \"arg\" $i) \"tmp\" }}\n\t\t\tif err != nil {\n\t\t\t\treturn nil, err\n\t\t\t}\n\t\t}\n\t\targs[{{$arg.GQLName|quote}}] = arg{{$i}}\n\t{{- end }}\n\treturn args, nil\n",
-	"field.gotpl":     "{{ $field := . }}\n{{ $object := $field.Object }}\n\n{{- if $object.Stream }}\n\tfunc (ec *executionContext) _{{$object.GQLType}}_{{$field.GQLName}}(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {\n\t\t{{- if $field.Args }}\n\t\t\trawArgs := field.ArgumentMap(ec.Variables)\n\t\t\targs, err := {{ $field.ArgsFunc }}(rawArgs)\n\t\t\tif err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn nil\n\t\t\t}\n\t\t{{- end }}\n\t\tctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{\n\t\t\tField: field,\n\t\t})\n\t\t// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259\n\t\t//          and Tracer stack\n\t\trctx := ctx\n\t\tresults, err := ec.resolvers.{{ $field.ShortInvocation }}\n\t\tif err != nil {\n\t\t\tec.Error(ctx, err)\n\t\t\treturn nil\n\t\t}\n\t\treturn func() graphql.Marshaler {\n\t\t\tres, ok := <-results\n\t\t\tif !ok {\n\t\t\t\treturn nil\n\t\t\t}\n\t\t\tvar out graphql.OrderedMap\n\t\t\tout.Add(field.Alias, func() graphql.Marshaler { {{ $field.WriteJson }} }())\n\t\t\treturn &out\n\t\t}\n\t}\n{{ else }}\n\t// nolint: vetshadow\n\tfunc (ec *executionContext) _{{$object.GQLType}}_{{$field.GQLName}}(ctx context.Context, field graphql.CollectedField, {{if not $object.Root}}obj *{{$object.FullName}}{{end}}) graphql.Marshaler {\n\t\tctx = ec.Tracer.StartFieldExecution(ctx, field)\n\t\tdefer func () { ec.Tracer.EndFieldExecution(ctx) }()\n\t\t{{- if $field.Args }}\n\t\t\trawArgs := field.ArgumentMap(ec.Variables)\n\t\t\targs, err := {{ $field.ArgsFunc }}(rawArgs)\n\t\t\tif err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn graphql.Null\n\t\t\t}\n\t\t{{- end }}\n\t\trctx := &graphql.ResolverContext{\n\t\t\tObject: {{$object.GQLType|quote}},\n\t\t\tArgs: {{if $field.Args }}args{{else}}nil{{end}},\n\t\t\tField: field,\n\t\t}\n\t\tctx = graphql.WithResolverContext(ctx, rctx)\n\t\tctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)\n\t\tresTmp := ec.FieldMiddleware(ctx, {{if $object.Root}}nil{{else}}obj{{end}}, func(rctx context.Context) (interface{}, error) {\n\t\t\tctx = rctx  // use context from middleware stack in children\n\t\t\t{{- if $field.IsResolver }}\n\t\t\t\treturn ec.resolvers.{{ $field.ShortInvocation }}\n\t\t\t{{- else if $field.IsMethod }}\n\t\t\t\t{{- if $field.NoErr }}\n\t\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}({{ $field.CallArgs }}), nil\n\t\t\t\t{{- else }}\n\t\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}({{ $field.CallArgs }})\n\t\t\t\t{{- end }}\n\t\t\t{{- else if $field.IsVariable }}\n\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}, nil\n\t\t\t{{- end }}\n\t\t})\n\t\tif resTmp == nil {\n\t\t\t{{- if $field.ASTType.NonNull }}\n\t\t\t\tif !ec.HasError(rctx) {\n\t\t\t\t\tec.Errorf(ctx, \"must not be null\")\n\t\t\t\t}\n\t\t\t{{- end }}\n\t\t\treturn graphql.Null\n\t\t}\n\t\tres := resTmp.({{$field.Signature}})\n\t\trctx.Result = res\n\t\tctx = ec.Tracer.StartFieldChildExecution(ctx)\n\t\t{{ $field.WriteJson }}\n\t}\n{{ end }}\n",
-	"generated.gotpl": "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"fmt\"  }}\n\t{{ reserveImport \"io\"  }}\n\t{{ reserveImport \"strconv\"  }}\n\t{{ reserveImport \"time\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"errors\"  }}\n\t{{ reserveImport \"bytes\"  }}\n\n\t{{ reserveImport \"github.com/vektah/gqlparser\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser/ast\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql/introspection\" }}\n)\n\n// NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.\nfunc NewExecutableSchema(cfg Config) graphql.ExecutableSchema {\n\treturn &executableSchema{\n\t\tresolvers: cfg.Resolvers,\n\t\tdirectives: cfg.Directives,\n\t\tcomplexity: cfg.Complexity,\n\t}\n}\n\ntype Config struct {\n\tResolvers  ResolverRoot\n\tDirectives DirectiveRoot\n\tComplexity ComplexityRoot\n}\n\ntype ResolverRoot interface {\n{{- range $object := .Objects -}}\n\t{{ if $object.HasResolvers -}}\n\t\t{{$object.GQLType}}() {{$object.GQLType}}Resolver\n\t{{ end }}\n{{- end }}\n}\n\ntype DirectiveRoot struct {\n{{ range $directive := .Directives }}\n\t{{ $directive.Declaration }}\n{{ end }}\n}\n\ntype ComplexityRoot struct {\n{{ range $object := .Objects }}\n\t{{ if not $object.IsReserved -}}\n\t\t{{ $object.GQLType|toCamel }} struct {\n\t\t{{ range $field := $object.Fields -}}\n\t\t\t{{ if not $field.IsReserved -}}\n\t\t\t\t{{ $field.GQLName|toCamel }} {{ $field.ComplexitySignature }}\n\t\t\t{{ end }}\n\t\t{{- end }}\n\t\t}\n\t{{- end }}\n{{ end }}\n}\n\n{{ range $object := .Objects -}}\n\t{{ if $object.HasResolvers }}\n\t\ttype {{$object.GQLType}}Resolver interface {\n\t\t{{ range $field := $object.Fields -}}\n\t\t\t{{ $field.ShortResolverDeclaration }}\n\t\t{{ end }}\n\t\t}\n\t{{- end }}\n{{- end }}\n\n{{ range $object := .Objects -}}\n\t{{ range $field := $object.Fields -}}\n\t\t{{ if $field.Args }}\n\t\t\tfunc {{ $field.ArgsFunc }}(rawArgs map[string]interface{}) (map[string]interface{}, error) {\n\t\t\t{{ template \"args.gotpl\" $field.Args }}\n\t\t\t}\n\t\t{{ end }}\n\t{{ end }}\n{{- end }}\n\n{{ range $directive := .Directives }}\n\t{{ if $directive.Args }}\n\t\tfunc {{ $directive.ArgsFunc }}(rawArgs map[string]interface{}) (map[string]interface{}, error) {\n\t\t{{ template \"args.gotpl\" $directive.Args }}\n\t\t}\n\t{{ end }}\n{{ end }}\n\ntype executableSchema struct {\n\tresolvers  ResolverRoot\n\tdirectives DirectiveRoot\n\tcomplexity ComplexityRoot\n}\n\nfunc (e *executableSchema) Schema() *ast.Schema {\n\treturn parsedSchema\n}\n\nfunc (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {\n\tswitch typeName + \".\" + field {\n\t{{ range $object := .Objects }}\n\t\t{{ if not $object.IsReserved }}\n\t\t\t{{ range $field := $object.Fields }}\n\t\t\t\t{{ if not $field.IsReserved }}\n\t\t\t\t\tcase \"{{$object.GQLType}}.{{$field.GQLName}}\":\n\t\t\t\t\t\tif e.complexity.{{$object.GQLType|toCamel}}.{{$field.GQLName|toCamel}} == nil {\n\t\t\t\t\t\t\tbreak\n\t\t\t\t\t\t}\n\t\t\t\t\t\t{{ if $field.Args }}\n\t\t\t\t\t\t\targs, err := {{ $field.ArgsFunc }}(rawArgs)\n\t\t\t\t\t\t\tif err != nil {\n\t\t\t\t\t\t\t\treturn 0, false\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t{{ end }}\n\t\t\t\t\t\treturn e.complexity.{{$object.GQLType|toCamel}}.{{$field.GQLName|toCamel}}(childComplexity{{if $field.Args}}, {{$field.ComplexityArgs}} {{end}}), true\n\t\t\t\t{{ end }}\n\t\t\t{{ end }}\n\t\t{{ end }}\n\t{{ end }}\n\t}\n\treturn 0, false\n}\n\nfunc (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {\n\t{{- if .QueryRoot }}\n\t\tec := executionContext{graphql.GetRequestContext(ctx), e}\n\n\t\tbuf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {\n\t\t\tdata := ec._{{.QueryRoot.GQLType}}(ctx, op.SelectionSet)\n\t\t\tvar buf bytes.Buffer\n\t\t\tdata.MarshalGQL(&buf)\n\t\t\treturn buf.Bytes()\n\t\t})\n\n\t\treturn &graphql.Response{\n\t\t\tData:       buf,\n\t\t\tErrors:     ec.Errors,\n\t\t\tExtensions: ec.Extensions,\t\t}\n\t{{- else }}\n\t\treturn graphql.ErrorResponse(ctx, \"queries are not supported\")\n\t{{- end }}\n}\n\nfunc (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {\n\t{{- if .MutationRoot }}\n\t\tec := executionContext{graphql.GetRequestContext(ctx), e}\n\n\t\tbuf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {\n\t\t\tdata := ec._{{.MutationRoot.GQLType}}(ctx, op.SelectionSet)\n\t\t\tvar buf bytes.Buffer\n\t\t\tdata.MarshalGQL(&buf)\n\t\t\treturn buf.Bytes()\n\t\t})\n\n\t\treturn &graphql.Response{\n\t\t\tData:       buf,\n\t\t\tErrors:     ec.Errors,\n\t\t\tExtensions: ec.Extensions,\n\t\t}\n\t{{- else }}\n\t\treturn graphql.ErrorResponse(ctx, \"mutations are not supported\")\n\t{{- end }}\n}\n\nfunc (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {\n\t{{- if .SubscriptionRoot }}\n\t\tec := executionContext{graphql.GetRequestContext(ctx), e}\n\n\t\tnext := ec._{{.SubscriptionRoot.GQLType}}(ctx, op.SelectionSet)\n\t\tif ec.Errors != nil {\n\t\t\treturn graphql.OneShot(&graphql.Response{Data: []byte(\"null\"), Errors: ec.Errors})\n\t\t}\n\n\t\tvar buf bytes.Buffer\n\t\treturn func() *graphql.Response {\n\t\t\tbuf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {\n\t\t\t\tbuf.Reset()\n\t\t\t\tdata := next()\n\n\t\t\t\tif data == nil {\n\t\t\t\t\treturn nil\n\t\t\t\t}\n\t\t\t\tdata.MarshalGQL(&buf)\n\t\t\t\treturn buf.Bytes()\n\t\t\t})\n\n\t\t\tif buf == nil {\n\t\t\t\treturn nil\n\t\t\t}\n\n\t\t\treturn &graphql.Response{\n\t\t\t\tData:       buf,\n\t\t\t\tErrors:     ec.Errors,\n\t\t\t\tExtensions: ec.Extensions,\n\t\t\t}\n\t\t}\n\t{{- else }}\n\t\treturn graphql.OneShot(graphql.ErrorResponse(ctx, \"subscriptions are not supported\"))\n\t{{- end }}\n}\n\ntype executionContext struct {\n\t*graphql.RequestContext\n\t*executableSchema\n}\n\n{{- range $object := .Objects }}\n\t{{ template \"object.gotpl\" $object }}\n\n\t{{- range $field := $object.Fields }}\n\t\t{{ template \"field.gotpl\" $field }}\n\t{{ end }}\n{{- end}}\n\n{{- range $interface := .Interfaces }}\n\t{{ template \"interface.gotpl\" $interface }}\n{{- end }}\n\n{{- range $input := .Inputs }}\n\t{{ template \"input.gotpl\" $input }}\n{{- end }}\n\nfunc (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) (ret interface{}) {\n\tdefer func() {\n\t\tif r := recover(); r != nil {\n\t\t\tec.Error(ctx, ec.Recover(ctx, r))\n\t\t\tret = nil\n\t\t}\n\t}()\n\t{{- if .Directives }}\n\trctx := graphql.GetResolverContext(ctx)\n\tfor _, d := range rctx.Field.Definition.Directives {\n\t\tswitch d.Name {\n\t\t{{- range $directive := .Directives }}\n\t\tcase \"{{$directive.Name}}\":\n\t\t\tif ec.directives.{{$directive.Name|ucFirst}} != nil {\n\t\t\t\t{{- if $directive.Args }}\n\t\t\t\t\trawArgs := d.ArgumentMap(ec.Variables)\n\t\t\t\t\targs, err := {{ $directive.ArgsFunc }}(rawArgs)\n\t\t\t\t\tif err != nil {\n\t\t\t\t\t\tec.Error(ctx, err)\n\t\t\t\t\t\treturn nil\n\t\t\t\t\t}\n\t\t\t\t{{- end }}\n\t\t\t\tn := next\n\t\t\t\tnext = func(ctx context.Context) (interface{}, error) {\n\t\t\t\t\treturn ec.directives.{{$directive.Name|ucFirst}}({{$directive.CallArgs}})\n\t\t\t\t}\n\t\t\t}\n\t\t{{- end }}\n\t\t}\n\t}\n\t{{- end }}\n\tres, err := ec.ResolverMiddleware(ctx, next)\n\tif err != nil {\n\t\tec.Error(ctx, err)\n\t\treturn nil\n\t}\n\treturn res\n}\n\nfunc (ec *executionContext) introspectSchema() (*introspection.Schema, error) {\n\tif ec.DisableIntrospection {\n\t\treturn nil, errors.New(\"introspection disabled\")\n\t}\n\treturn introspection.WrapSchema(parsedSchema), nil\n}\n\nfunc (ec *executionContext) introspectType(name string) (*introspection.Type, error) {\n\tif ec.DisableIntrospection {\n\t\treturn nil, errors.New(\"introspection disabled\")\n\t}\n\treturn introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil\n}\n\nvar parsedSchema = gqlparser.MustLoadSchema(\n\t{{- range $filename, $schema := .SchemaRaw }}\n\t\t&ast.Source{Name: {{$filename|quote}}, Input: {{$schema|rawQuote}}},\n\t{{- end }}\n)\n",
-	"input.gotpl":     "\t{{- if .IsMarshaled }}\n\tfunc Unmarshal{{ .GQLType }}(v interface{}) ({{.FullName}}, error) {\n\t\tvar it {{.FullName}}\n\t\tvar asMap = v.(map[string]interface{})\n\t\t{{ range $field := .Fields}}\n\t\t\t{{- if $field.Default}}\n\t\t\t\tif _, present := asMap[{{$field.GQLName|quote}}] ; !present {\n\t\t\t\t\tasMap[{{$field.GQLName|quote}}] = {{ $field.Default | dump }}\n\t\t\t\t}\n\t\t\t{{- end}}\n\t\t{{- end }}\n\n\t\tfor k, v := range asMap {\n\t\t\tswitch k {\n\t\t\t{{- range $field := .Fields }}\n\t\t\tcase {{$field.GQLName|quote}}:\n\t\t\t\tvar err error\n\t\t\t\t{{ $field.Unmarshal (print \"it.\" $field.GoFieldName) \"v\" }}\n\t\t\t\tif err != nil {\n\t\t\t\t\treturn it, err\n\t\t\t\t}\n\t\t\t{{- end }}\n\t\t\t}\n\t\t}\n\n\t\treturn it, nil\n\t}\n\t{{- end }}\n",
-	"interface.gotpl": "{{- $interface := . }}\n\nfunc (ec *executionContext) _{{$interface.GQLType}}(ctx context.Context, sel ast.SelectionSet, obj *{{$interface.FullName}}) graphql.Marshaler {\n\tswitch obj := (*obj).(type) {\n\tcase nil:\n\t\treturn graphql.Null\n\t{{- range $implementor := $interface.Implementors }}\n\t\t{{- if $implementor.ValueReceiver }}\n\t\t\tcase {{$implementor.FullName}}:\n\t\t\t\treturn ec._{{$implementor.GQLType}}(ctx, sel, &obj)\n\t\t{{- end}}\n\t\tcase *{{$implementor.FullName}}:\n\t\t\treturn ec._{{$implementor.GQLType}}(ctx, sel, obj)\n\t{{- end }}\n\tdefault:\n\t\tpanic(fmt.Errorf(\"unexpected type %T\", obj))\n\t}\n}\n",
-	"models.gotpl":    "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"fmt\"  }}\n\t{{ reserveImport \"io\"  }}\n\t{{ reserveImport \"strconv\"  }}\n\t{{ reserveImport \"time\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"errors\"  }}\n\t{{ reserveImport \"bytes\"  }}\n\n\t{{ reserveImport \"github.com/vektah/gqlparser\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser/ast\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql/introspection\" }}\n)\n\n{{ range $model := .Models }}\n\t{{with .Description}} {{.|prefixLines \"// \"}} {{end}}\n\t{{- if .IsInterface }}\n\t\ttype {{.GoType}} interface {\n\t\t\tIs{{.GoType}}()\n\t\t}\n\t{{- else }}\n\t\ttype {{.GoType}} struct {\n\t\t\t{{- range $field := .Fields }}\n\t\t\t\t{{- with .Description}}\n\t\t\t\t\t{{.|prefixLines \"// \"}}\n\t\t\t\t{{- end}}\n\t\t\t\t{{- if $field.GoFieldName }}\n\t\t\t\t\t{{ $field.GoFieldName }} {{$field.Signature}} `json:\"{{$field.GQLName}}\"`\n\t\t\t\t{{- else }}\n\t\t\t\t\t{{ $field.GoFKName }} {{$field.GoFKType}}\n\t\t\t\t{{- end }}\n\t\t\t{{- end }}\n\t\t}\n\n\t\t{{- range $iface := .Implements }}\n\t\t\tfunc ({{$model.GoType}}) Is{{$iface.GoType}}() {}\n\t\t{{- end }}\n\n\t{{- end }}\n{{- end}}\n\n{{ range $enum := .Enums }}\n\t{{with .Description}}{{.|prefixLines \"// \"}} {{end}}\n\ttype {{.GoType}} string\n\tconst (\n\t{{- range $value := .Values}}\n\t\t{{- with .Description}}\n\t\t\t{{.|prefixLines \"// \"}}\n\t\t{{- end}}\n\t\t{{$enum.GoType}}{{ .Name|toCamel }} {{$enum.GoType}} = {{.Name|quote}}\n\t{{- end }}\n\t)\n\n\tfunc (e {{.GoType}}) IsValid() bool {\n\t\tswitch e {\n\t\tcase {{ range $index, $element := .Values}}{{if $index}},{{end}}{{ $enum.GoType }}{{ $element.Name|toCamel }}{{end}}:\n\t\t\treturn true\n\t\t}\n\t\treturn false\n\t}\n\n\tfunc (e {{.GoType}}) String() string {\n\t\treturn string(e)\n\t}\n\n\tfunc (e *{{.GoType}}) UnmarshalGQL(v interface{}) error {\n\t\tstr, ok := v.(string)\n\t\tif !ok {\n\t\t\treturn fmt.Errorf(\"enums must be strings\")\n\t\t}\n\n\t\t*e = {{.GoType}}(str)\n\t\tif !e.IsValid() {\n\t\t\treturn fmt.Errorf(\"%s is not a valid {{.GQLType}}\", str)\n\t\t}\n\t\treturn nil\n\t}\n\n\tfunc (e {{.GoType}}) MarshalGQL(w io.Writer) {\n\t\tfmt.Fprint(w, strconv.Quote(e.String()))\n\t}\n\n{{- end }}\n",
-	"object.gotpl":    "{{ $object := . }}\n\nvar {{ $object.GQLType|lcFirst}}Implementors = {{$object.Implementors}}\n\n// nolint: gocyclo, errcheck, gas, goconst\n{{- if .Stream }}\nfunc (ec *executionContext) _{{$object.GQLType}}(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {\n\tfields := graphql.CollectFields(ctx, sel, {{$object.GQLType|lcFirst}}Implementors)\n\tctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{\n\t\tObject: {{$object.GQLType|quote}},\n\t})\n\tif len(fields) != 1 {\n\t\tec.Errorf(ctx, \"must subscribe to exactly one stream\")\n\t\treturn nil\n\t}\n\n\tswitch fields[0].Name {\n\t{{- range $field := $object.Fields }}\n\tcase \"{{$field.GQLName}}\":\n\t\treturn ec._{{$object.GQLType}}_{{$field.GQLName}}(ctx, fields[0])\n\t{{- end }}\n\tdefault:\n\t\tpanic(\"unknown field \" + strconv.Quote(fields[0].Name))\n\t}\n}\n{{- else }}\nfunc (ec *executionContext) _{{$object.GQLType}}(ctx context.Context, sel ast.SelectionSet{{if not $object.Root}}, obj *{{$object.FullName}} {{end}}) graphql.Marshaler {\n\tfields := graphql.CollectFields(ctx, sel, {{$object.GQLType|lcFirst}}Implementors)\n\t{{if $object.Root}}\n\t\tctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{\n\t\t\tObject: {{$object.GQLType|quote}},\n\t\t})\n\t{{end}}\n\n\t{{if $object.IsConcurrent}} var wg sync.WaitGroup {{end}}\n\tout := graphql.NewOrderedMap(len(fields))\n\tinvalid := false\n\tfor i, field := range fields {\n\t\tout.Keys[i] = field.Alias\n\n\t\tswitch field.Name {\n\t\tcase \"__typename\":\n\t\t\tout.Values[i] = graphql.MarshalString({{$object.GQLType|quote}})\n\t\t{{- range $field := $object.Fields }}\n\t\tcase \"{{$field.GQLName}}\":\n\t\t\t{{- if $field.IsConcurrent }}\n\t\t\t\twg.Add(1)\n\t\t\t\tgo func(i int, field graphql.CollectedField) {\n\t\t\t{{- end }}\n\t\t\t\tout.Values[i] = ec._{{$object.GQLType}}_{{$field.GQLName}}(ctx, field{{if not $object.Root}}, obj{{end}})\n\t\t\t\t{{- if $field.ASTType.NonNull }}\n\t\t\t\t\tif out.Values[i] == graphql.Null {\n\t\t\t\t\t\tinvalid = true\n\t\t\t\t\t}\n\t\t\t\t{{- end }}\n\t\t\t{{- if $field.IsConcurrent }}\n\t\t\t\t\twg.Done()\n\t\t\t\t}(i, field)\n\t\t\t{{- end }}\n\t\t{{- end }}\n\t\tdefault:\n\t\t\tpanic(\"unknown field \" + strconv.Quote(field.Name))\n\t\t}\n\t}\n\t{{if $object.IsConcurrent}} wg.Wait() {{end}}\n\tif invalid { return graphql.Null }\n\treturn out\n}\n{{- end }}\n",
-	"resolver.gotpl":  "package {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"fmt\"  }}\n\t{{ reserveImport \"io\"  }}\n\t{{ reserveImport \"strconv\"  }}\n\t{{ reserveImport \"time\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"errors\"  }}\n\t{{ reserveImport \"bytes\"  }}\n\n\t{{ reserveImport \"github.com/99designs/gqlgen/handler\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser/ast\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql/introspection\" }}\n)\n\ntype {{.ResolverType}} struct {}\n\n{{ range $object := .Objects -}}\n\t{{- if $object.HasResolvers -}}\n\t\tfunc (r *{{$.ResolverType}}) {{$object.GQLType}}() {{ $object.ResolverInterface.FullName }} {\n\t\t\treturn &{{lcFirst $object.GQLType}}Resolver{r}\n\t\t}\n\t{{ end -}}\n{{ end }}\n\n{{ range $object := .Objects -}}\n\t{{- if $object.HasResolvers -}}\n\t\ttype {{lcFirst $object.GQLType}}Resolver struct { *Resolver }\n\n\t\t{{ range $field := $object.Fields -}}\n\t\t\t{{- if $field.IsResolver -}}\n\t\t\tfunc (r *{{lcFirst $object.GQLType}}Resolver) {{ $field.ShortResolverDeclaration }} {\n\t\t\t\tpanic(\"not implemented\")\n\t\t\t}\n\t\t\t{{ end -}}\n\t\t{{ end -}}\n\t{{ end -}}\n{{ end }}\n",
-	"server.gotpl":    "package main\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\" }}\n\t{{ reserveImport \"log\" }}\n\t{{ reserveImport \"net/http\" }}\n\t{{ reserveImport \"os\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/handler\" }}\n)\n\nconst defaultPort = \"8080\"\n\nfunc main() {\n\tport := os.Getenv(\"PORT\")\n\tif port == \"\" {\n\t\tport = defaultPort\n\t}\n\n\thttp.Handle(\"/\", handler.Playground(\"GraphQL playground\", \"/query\"))\n\thttp.Handle(\"/query\", handler.GraphQL({{ lookupImport .ExecPackageName }}.NewExecutableSchema({{ lookupImport .ExecPackageName}}.Config{Resolvers: &{{ lookupImport .ResolverPackageName}}.Resolver{}})))\n\n\tlog.Printf(\"connect to http://localhost:%s/ for GraphQL playground\", port)\n\tlog.Fatal(http.ListenAndServe(\":\" + port, nil))\n}\n",
+	"args.gotpl":        "\targs := map[string]interface{}{}\n\t{{- range $i, $arg := . }}\n\t\tvar arg{{$i}} {{$arg.Signature }}\n\t\tif tmp, ok := rawArgs[{{$arg.GQLName|quote}}]; ok {\n\t\t\tvar err error\n\t\t\t{{$arg.Unmarshal (print \"arg\" $i) \"tmp\" }}\n\t\t\tif err != nil {\n\t\t\t\treturn nil, {{ if $arg.HasConstraints }}graphql.PrefixConstraintPath(err, {{$arg.GQLName|quote}}){{ else }}err{{ end }}\n\t\t\t}\n\t\t\t{{- with $arg.Constraint }}\n\t\t\t\tif err := {{ .VarName }}.Check({{$arg.GQLName|quote}}, arg{{$i}}); err != nil {\n\t\t\t\t\treturn nil, err\n\t\t\t\t}\n\t\t\t{{- end }}\n\t\t}\n\t\targs[{{$arg.GQLName|quote}}] = arg{{$i}}\n\t{{- end }}\n\treturn args, nil\n",
+	"args_struct.gotpl": "{{ $field := . }}\ntype {{ $field.ArgsStruct.GoType }} struct {\n\t{{- range $arg := $field.Args }}\n\t\t{{ $arg.StructField }} {{ $arg.Signature }} `json:\"{{ $arg.GQLName }}\"`\n\t{{- end }}\n}\n\n{{ template \"constraints.gotpl\" $field.Args }}\n\nfunc {{ $field.ArgsFunc }}(rawArgs map[string]interface{}) ({{ $field.ArgsStruct.GoType }}, error) {\n\tvar args {{ $field.ArgsStruct.GoType }}\n\t{{- range $arg := $field.Args }}\n\t\tif tmp, ok := rawArgs[{{$arg.GQLName|quote}}]; ok {\n\t\t\tvar err error\n\t\t\t{{$arg.Unmarshal (print \"args.\" $arg.StructField) \"tmp\" }}\n\t\t\tif err != nil {\n\t\t\t\treturn args, {{ if $arg.HasConstraints }}graphql.PrefixConstraintPath(err, {{$arg.GQLName|quote}}){{ else }}err{{ end }}\n\t\t\t}\n\t\t\t{{- with $arg.Constraint }}\n\t\t\t\tif err := {{ .VarName }}.Check({{$arg.GQLName|quote}}, args.{{ $arg.StructField }}); err != nil {\n\t\t\t\t\treturn args, err\n\t\t\t\t}\n\t\t\t{{- end }}\n\t\t}\n\t{{- end }}\n\treturn args, nil\n}\n",
+	"client.gotpl":      "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\" }}\n\n\t{{ reserveImport \"github.com/99designs/gqlgen/client\" }}\n)\n\n// {{ .ClientType }} sends the operations it was generated for, so that the variables and responses are checked\n// against the schema at compile time\ntype {{ .ClientType }} struct {\n\tTransport client.Transport\n}\n\n// New{{ .ClientType }} creates a client that sends its requests with the transport, eg\n// &client.HTTPTransport{URL: \"http://localhost:8080/query\"}\nfunc New{{ .ClientType }}(transport client.Transport) *{{ .ClientType }} {\n\treturn &{{ .ClientType }}{Transport: transport}\n}\n\n{{- range $op := .Operations }}\n\nconst {{ $op.Name }}Document = {{ $op.Document|rawQuote }}\n\n// {{ $op.Name }} sends the {{ $op.GQLName }} {{ $op.Operation }}. Any errors are returned along with the partial response.\nfunc (c *{{ $.ClientType }}) {{ $op.Name }}(ctx context.Context{{ range $var := $op.Variables }}, {{ $var.GoVarName }} {{ $var.Type }}{{ end }}) (*{{ $op.Response.Name }}, error) {\n\tvars := map[string]interface{}{\n\t{{- range $var := $op.Variables }}\n\t\t{{- if not $var.Optional }}\n\t\t{{ $var.GQLName|quote }}: {{ $var.GoVarName }},\n\t\t{{- end }}\n\t{{- end }}\n\t}\n\t{{- range $var := $op.Variables }}\n\t{{- if $var.Optional }}\n\tif {{ $var.GoVarName }} != nil {\n\t\tvars[{{ $var.GQLName|quote }}] = {{ $var.GoVarName }}\n\t}\n\t{{- end }}\n\t{{- end }}\n\n\tvar resp {{ $op.Response.Name }}\n\terr := c.Transport.Do(ctx, &client.Request{Query: {{ $op.Name }}Document, OperationName: {{ $op.GQLName|quote }}, Variables: vars}, &resp)\n\treturn &resp, err\n}\n{{- end }}\n\n{{- range $struct := .Structs }}\n\ntype {{ $struct.Name }} struct {\n\t{{- range $field := $struct.Fields }}\n\t{{- with $field.Description }}\n\t{{ .|prefixLines \"// \" }}\n\t{{- end }}\n\t{{ $field.GoName }} {{ $field.Type }} `{{ $field.Tag }}`\n\t{{- end }}\n}\n{{- end }}\n\n{{- range $input := .Inputs }}\n\n{{ with $input.Description }}{{ .|prefixLines \"// \" }}\n{{ end -}}\ntype {{ $input.Name }} struct {\n\t{{- range $field := $input.Fields }}\n\t{{- with $field.Description }}\n\t{{ .|prefixLines \"// \" }}\n\t{{- end }}\n\t{{ $field.GoName }} {{ $field.Type }} `{{ $field.Tag }}`\n\t{{- end }}\n}\n{{- end }}\n\n{{- range $enum := .Enums }}\n\n{{ with $enum.Description }}{{ .|prefixLines \"// \" }}\n{{ end -}}\ntype {{ $enum.Name }} string\n\nconst (\n{{- range $value := $enum.Values }}\n\t{{- with $value.Description }}\n\t{{ .|prefixLines \"// \" }}\n\t{{- end }}\n\t{{ $value.Name }} {{ $enum.Name }} = {{ $value.Value|quote }}\n{{- end }}\n)\n{{- end }}\n",
+	"constraints.gotpl": "{{- range $arg := . }}\n\t{{- with $arg.Constraint }}\n\t\tvar {{ .VarName }} = graphql.MustConstraint({{ .Args | dump }})\n\t{{- end }}\n{{- end }}\n",
+	"enum.gotpl":        "{{- $enum := . }}\nfunc Unmarshal{{ .GQLType }}(v interface{}) ({{.FullName}}, error) {\n\tvar it {{.FullName}}\n\tstr, ok := v.(string)\n\tif !ok {\n\t\treturn it, fmt.Errorf(\"enums must be strings\")\n\t}\n\n\tswitch str {\n\t{{- range $i, $value := .Values }}\n\tcase {{ $value.Name|quote }}:\n\t\treturn {{ (index $enum.Constants $i).FullName }}, nil\n\t{{- end }}\n\t}\n\treturn it, fmt.Errorf(\"%s is not a valid {{.GQLType}}, expected one of {{.ValueNames}}\", str)\n}\n\n// Marshal{{ .GQLType }} returns null for go values that aren't mapped to a graphql value, a field that resolves to one\n// of them gets an error\nfunc Marshal{{ .GQLType }}(v {{.FullName}}) graphql.Marshaler {\n\tswitch v {\n\t{{- range $i, $value := .Values }}\n\tcase {{ (index $enum.Constants $i).FullName }}:\n\t\treturn graphql.MarshalString({{ $value.Name|quote }})\n\t{{- end }}\n\t}\n\treturn graphql.Null\n}\n",
+	"federation.gotpl":  "{{- $federation := . }}\n\n// resolveService returns the schema of this service, which the gateway composes into the federated graph\nfunc (ec *executionContext) resolveService(ctx context.Context) ({{ $federation.Service.Signature }}, error) {\n\treturn {{ $federation.Service.Signature }}{SDL: federationSDL}, nil\n}\n\nconst federationSDL = {{ $federation.SDL|rawQuote }}\n\n{{- with $federation.Entities }}\n\n// resolveEntities finds each of the entities the gateway needs from this service, by the fields of one of its keys.\n// A representation that can't be resolved is null, with its error reported at the index of the representation.\nfunc (ec *executionContext) resolveEntities(ctx context.Context, representations {{ (index .Args 0).Signature }}) ({{ .Signature }}, error) {\n\tentities := make({{ .Signature }}, len(representations))\n\tfor i, representation := range representations {\n\t\tentity, err := ec.resolveEntity(graphql.WithEntityRepresentation(ctx, representation), representation)\n\t\tif err != nil {\n\t\t\tindex := i\n\t\t\tec.Error(graphql.WithResolverContext(ctx, &graphql.ResolverContext{Index: &index}), err)\n\t\t\tcontinue\n\t\t}\n\t\tif entity != nil {\n\t\t\tentities[i] = &entity\n\t\t}\n\t}\n\treturn entities, nil\n}\n\n// resolveEntity uses the __typename of a representation to pick the entity, and the first key it has all the fields of\n// to pick the EntityResolver method. Entities that can't be found are null.\nfunc (ec *executionContext) resolveEntity(ctx context.Context, representation map[string]interface{}) ({{ .FullName }}, error) {\n\ttypename, _ := representation[\"__typename\"].(string)\n\tswitch typename {\n\t{{- range $entity := $federation.Types }}\n\tcase {{ $entity.Name|quote }}:\n\t\t{{- range $find := $entity.Finders }}\n\t\tif {{ range $i, $arg := $find.Args }}{{ if $i }} && {{ end }}representation[{{ $arg.GQLName|quote }}] != nil{{ end }} {\n\t\t\targs, err := {{ $find.ArgsFunc }}(representation)\n\t\t\tif err != nil {\n\t\t\t\treturn nil, err\n\t\t\t}\n\t\t\tentity, err := ec.resolvers.Entity().{{ $find.GoNameExported }}(ctx{{ range $arg := $find.Args }}, args[{{ $arg.GQLName|quote }}].({{ $arg.Signature }}){{ end }})\n\t\t\tif entity == nil {\n\t\t\t\treturn nil, err\n\t\t\t}\n\t\t\treturn entity, err\n\t\t}\n\t\t{{- end }}\n\t\treturn nil, fmt.Errorf(\"the representation of {{ $entity.Name }} doesn't have the fields of any of its keys\")\n\t{{- end }}\n\t}\n\treturn nil, fmt.Errorf(\"%s is not an entity\", typename)\n}\n\n{{- range $entity := $federation.Types }}\n\t{{- range $find := $entity.Finders }}\n\nfunc {{ $find.ArgsFunc }}(rawArgs map[string]interface{}) (map[string]interface{}, error) {\n\t{{ template \"args.gotpl\" $find.Args }}\n}\n\t{{- end }}\n{{- end }}\n{{- end }}\n",
+	"field.gotpl":       "{{ $field := . }}\n{{ $object := $field.Object }}\n\n{{- if $object.Stream }}\n\tfunc (ec *executionContext) _{{$object.GQLType}}_{{$field.GQLName}}(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {\n\t\t{{- if $field.Args }}\n\t\t\trawArgs := graphql.ArgumentMap(field.Field, ec.Variables)\n\t\t\targs, err := {{ $field.ArgsFunc }}(rawArgs)\n\t\t\tif err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn nil\n\t\t\t}\n\t\t{{- end }}\n\t\tctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{\n\t\t\tField: field,\n\t\t})\n\t\t{{- if $field.HasArgDirectives }}\n\t\t\tif err := ec.{{ $field.ArgDirectivesFunc }}(ctx, {{ if $field.ArgsStruct }}&{{ end }}args); err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn nil\n\t\t\t}\n\t\t{{- end }}\n\t\t// FIXME: subscriptions are missing request middleware stack https://github.com/99designs/gqlgen/issues/259\n\t\t//          and Tracer stack\n\t\trctx := ctx\n\t\tresults, err := ec.resolvers.{{ $field.ShortInvocation }}\n\t\tif err != nil {\n\t\t\tec.Error(ctx, err)\n\t\t\treturn nil\n\t\t}\n\t\treturn func() graphql.Marshaler {\n\t\t\tres, ok := <-results\n\t\t\tif !ok {\n\t\t\t\treturn nil\n\t\t\t}\n\t\t\tvar out graphql.OrderedMap\n\t\t\tout.Add(field.Alias, func() graphql.Marshaler { {{ $field.WriteJson }} }())\n\t\t\treturn &out\n\t\t}\n\t}\n{{ else }}\n\t// nolint: vetshadow\n\tfunc (ec *executionContext) _{{$object.GQLType}}_{{$field.GQLName}}(ctx context.Context, field graphql.CollectedField, {{if not $object.Root}}obj *{{$object.FullName}}{{end}}) graphql.Marshaler {\n\t\tctx = ec.Tracer.StartFieldExecution(ctx, field)\n\t\tdefer func () { ec.Tracer.EndFieldExecution(ctx) }()\n\t\t{{- if $field.Args }}\n\t\t\trawArgs := graphql.ArgumentMap(field.Field, ec.Variables)\n\t\t\targs, err := {{ $field.ArgsFunc }}(rawArgs)\n\t\t\tif err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn graphql.Null\n\t\t\t}\n\t\t{{- end }}\n\t\trctx := &graphql.ResolverContext{\n\t\t\tObject: {{$object.GQLType|quote}},\n\t\t\tArgs: {{if and $field.Args (not $field.ArgsStruct) }}args{{else}}nil{{end}},\n\t\t\t{{- if $field.ArgsStruct }}\n\t\t\t\tTypedArgs: &args,\n\t\t\t{{- end }}\n\t\t\tField: field,\n\t\t}\n\t\tctx = graphql.WithResolverContext(ctx, rctx)\n\t\t{{- if $field.HasArgDirectives }}\n\t\t\tif err := ec.{{ $field.ArgDirectivesFunc }}(ctx, {{ if $field.ArgsStruct }}&{{ end }}args); err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn graphql.Null\n\t\t\t}\n\t\t{{- end }}\n\t\tctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)\n\t\tresTmp := ec.FieldMiddleware(ctx, {{if $object.Root}}nil{{else}}obj{{end}}, func(rctx context.Context) (interface{}, error) {\n\t\t\tctx = rctx  // use context from middleware stack in children\n\t\t\t{{- if $field.IsResolver }}\n\t\t\t\treturn graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {\n\t\t\t\t\treturn ec.resolvers.{{ $field.ShortInvocation }}\n\t\t\t\t})\n\t\t\t{{- else if $field.IsMethod }}\n\t\t\t\t{{- if $field.MethodHasContext }}\n\t\t\t\t\treturn graphql.ResolveWithTimeout(rctx, func(ctx context.Context) (interface{}, error) {\n\t\t\t\t\t\t{{- if $field.NoErr }}\n\t\t\t\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}({{ $field.CallArgs }}), nil\n\t\t\t\t\t\t{{- else }}\n\t\t\t\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}({{ $field.CallArgs }})\n\t\t\t\t\t\t{{- end }}\n\t\t\t\t\t})\n\t\t\t\t{{- else if $field.NoErr }}\n\t\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}({{ $field.CallArgs }}), nil\n\t\t\t\t{{- else }}\n\t\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}({{ $field.CallArgs }})\n\t\t\t\t{{- end }}\n\t\t\t{{- else if $field.IsVariable }}\n\t\t\t\treturn {{$field.GoReceiverName}}.{{$field.GoFieldName}}, nil\n\t\t\t{{- end }}\n\t\t})\n\t\tif resTmp == nil {\n\t\t\t{{- if $field.ASTType.NonNull }}\n\t\t\t\tif !ec.HasError(rctx) {\n\t\t\t\t\tec.Errorf(ctx, \"must not be null\")\n\t\t\t\t}\n\t\t\t{{- end }}\n\t\t\treturn graphql.Null\n\t\t}\n\t\tres := resTmp.({{$field.Signature}})\n\t\trctx.Result = res\n\t\tctx = ec.Tracer.StartFieldChildExecution(ctx)\n\t\t{{ $field.WriteJson }}\n\t}\n{{ end }}\n",
+	"generated.gotpl":   "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"fmt\"  }}\n\t{{ reserveImport \"io\"  }}\n\t{{ reserveImport \"strconv\"  }}\n\t{{ reserveImport \"time\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"errors\"  }}\n\t{{ reserveImport \"bytes\"  }}\n\n\t{{ reserveImport \"github.com/vektah/gqlparser\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser/ast\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql/introspection\" }}\n)\n\n{{ if $.IsRoot -}}\n// NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.\nfunc NewExecutableSchema(cfg Config) graphql.ExecutableSchema {\n\treturn &executableSchema{\n\t\tresolvers: cfg.Resolvers,\n\t\tdirectives: cfg.Directives,\n\t\tcomplexity: cfg.Complexity,\n\t\tconcurrencyLimit: cfg.ConcurrencyLimit,\n\t}\n}\n\ntype Config struct {\n\tResolvers  ResolverRoot\n\tDirectives DirectiveRoot\n\tComplexity ComplexityRoot\n\t// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,\n\t// it is used when the request context doesn't set its own limit.\n\tConcurrencyLimit int\n}\n\ntype ResolverRoot interface {\n{{- range $object := .Objects -}}\n\t{{ if $object.HasResolvers -}}\n\t\t{{$object.GQLType}}() {{$object.GQLType}}Resolver\n\t{{ end }}\n{{- end }}\n{{- with .Relay }}{{ if .Resolver.HasResolvers -}}\n\tNode() NodeResolver\n{{ end }}{{ end -}}\n{{- with .Federation }}{{ if .Resolver.HasResolvers -}}\n\tEntity() EntityResolver\n{{ end }}{{ end -}}\n}\n\ntype DirectiveRoot struct {\n{{ range $directive := .Directives }}\n\t{{ $directive.Declaration }}\n{{ end }}\n}\n\ntype ComplexityRoot struct {\n{{ range $object := .Objects }}\n\t{{ if not $object.IsReserved -}}\n\t\t{{ $object.GQLType|toCamel }} struct {\n\t\t{{ range $field := $object.Fields -}}\n\t\t\t{{ if not $field.IsReserved -}}\n\t\t\t\t{{ $field.GQLName|toCamel }} {{ $field.ComplexitySignature }}\n\t\t\t{{ end }}\n\t\t{{- end }}\n\t\t}\n\t{{- end }}\n{{ end }}\n}\n\n{{ range $object := .Objects -}}\n\t{{ if $object.HasResolvers }}\n\t\ttype {{$object.GQLType}}Resolver interface {\n\t\t{{ range $field := $object.Fields -}}\n\t\t\t{{ $field.ShortResolverDeclaration }}\n\t\t{{ end }}\n\t\t}\n\t{{- end }}\n{{- end }}\n\n{{- with .Relay }}{{ if .Resolver.HasResolvers }}\n\t// NodeResolver fetches the types that implement Node, the global id has already been decoded\n\ttype NodeResolver interface {\n\t{{ range $field := .Resolver.Fields -}}\n\t\t{{ $field.ShortResolverDeclaration }}\n\t{{ end }}\n\t}\n{{- end }}{{ end }}\n\n{{- with .Federation }}{{ if .Resolver.HasResolvers }}\n\t// EntityResolver finds the entities the gateway needs from this service, by the fields of one of their keys\n\ttype EntityResolver interface {\n\t{{ range $field := .Resolver.Fields -}}\n\t\t{{ $field.ShortResolverDeclaration }}\n\t{{ end }}\n\t}\n{{- end }}{{ end }}\n\n{{- end }}\n\n{{ range $object := .Objects -}}\n\t{{ if $.HasType $object.GQLType -}}\n\t{{ range $field := $object.Fields -}}\n\t\t{{ if $field.ArgsStruct }}\n\t\t\t{{ template \"args_struct.gotpl\" $field }}\n\t\t{{ else if $field.Args }}\n\t\t\t{{ template \"constraints.gotpl\" $field.Args }}\n\t\t\tfunc {{ $field.ArgsFunc }}(rawArgs map[string]interface{}) (map[string]interface{}, error) {\n\t\t\t{{ template \"args.gotpl\" $field.Args }}\n\t\t\t}\n\t\t{{ end }}\n\t\t{{ if $field.HasArgDirectives }}\n\t\t\tfunc (ec *executionContext) {{ $field.ArgDirectivesFunc }}(ctx context.Context, args {{ if $field.ArgsStruct }}*{{ $field.ArgsStruct.GoType }}{{ else }}map[string]interface{}{{ end }}) error {\n\t\t\t\t{{- range $arg := $field.Args }}\n\t\t\t\t\t{{- if or $arg.Directives $arg.HasInputDirectives }}\n\t\t\t\t\t\t{{ $field.ArgDirectives $arg }}\n\t\t\t\t\t{{- end }}\n\t\t\t\t{{- end }}\n\t\t\t\treturn nil\n\t\t\t}\n\t\t{{ end }}\n\t{{ end }}\n\t{{- end }}\n{{- end }}\n\n{{ if $.IsRoot -}}\n{{ range $directive := .Directives }}\n\t{{ if $directive.Args }}\n\t\tfunc {{ $directive.ArgsFunc }}(rawArgs map[string]interface{}) (map[string]interface{}, error) {\n\t\t{{ template \"args.gotpl\" $directive.Args }}\n\t\t}\n\t{{ end }}\n{{ end }}\n\ntype executableSchema struct {\n\tresolvers  ResolverRoot\n\tdirectives DirectiveRoot\n\tcomplexity ComplexityRoot\n\tconcurrencyLimit int\n}\n\nfunc (e *executableSchema) Schema() *ast.Schema {\n\treturn parsedSchema\n}\n\nfunc (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {\n\tswitch typeName + \".\" + field {\n\t{{ range $object := .Objects }}\n\t\t{{ if not $object.IsReserved }}\n\t\t\t{{ range $field := $object.Fields }}\n\t\t\t\t{{ if not $field.IsReserved }}\n\t\t\t\t\tcase \"{{$object.GQLType}}.{{$field.GQLName}}\":\n\t\t\t\t\t\tif e.complexity.{{$object.GQLType|toCamel}}.{{$field.GQLName|toCamel}} == nil {\n\t\t\t\t\t\t\tbreak\n\t\t\t\t\t\t}\n\t\t\t\t\t\t{{ if $field.Args }}\n\t\t\t\t\t\t\targs, err := {{ $field.ArgsFunc }}(rawArgs)\n\t\t\t\t\t\t\tif err != nil {\n\t\t\t\t\t\t\t\treturn 0, false\n\t\t\t\t\t\t\t}\n\t\t\t\t\t\t{{ end }}\n\t\t\t\t\t\treturn e.complexity.{{$object.GQLType|toCamel}}.{{$field.GQLName|toCamel}}(childComplexity{{if $field.Args}}, {{$field.ComplexityArgs}} {{end}}), true\n\t\t\t\t{{ end }}\n\t\t\t{{ end }}\n\t\t{{ end }}\n\t{{ end }}\n\t}\n\treturn 0, false\n}\n\nfunc (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {\n\t{{- if .QueryRoot }}\n\t\tec := e.newExecutionContext(ctx)\n\n\t\tbuf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {\n\t\t\tdata := ec._{{.QueryRoot.GQLType}}(ctx, op.SelectionSet)\n\t\t\tvar buf bytes.Buffer\n\t\t\tdata.MarshalGQL(&buf)\n\t\t\treturn buf.Bytes()\n\t\t})\n\n\t\treturn &graphql.Response{\n\t\t\tData:       buf,\n\t\t\tErrors:     ec.Errors,\n\t\t\tExtensions: ec.Extensions,\t\t}\n\t{{- else }}\n\t\treturn graphql.ErrorResponse(ctx, \"queries are not supported\")\n\t{{- end }}\n}\n\nfunc (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {\n\t{{- if .MutationRoot }}\n\t\tec := e.newExecutionContext(ctx)\n\n\t\tbuf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {\n\t\t\tdata := ec._{{.MutationRoot.GQLType}}(ctx, op.SelectionSet)\n\t\t\tvar buf bytes.Buffer\n\t\t\tdata.MarshalGQL(&buf)\n\t\t\treturn buf.Bytes()\n\t\t})\n\n\t\treturn &graphql.Response{\n\t\t\tData:       buf,\n\t\t\tErrors:     ec.Errors,\n\t\t\tExtensions: ec.Extensions,\n\t\t}\n\t{{- else }}\n\t\treturn graphql.ErrorResponse(ctx, \"mutations are not supported\")\n\t{{- end }}\n}\n\nfunc (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {\n\t{{- if .SubscriptionRoot }}\n\t\tec := e.newExecutionContext(ctx)\n\n\t\tnext := ec._{{.SubscriptionRoot.GQLType}}(ctx, op.SelectionSet)\n\t\tif ec.Errors != nil {\n\t\t\treturn graphql.OneShot(&graphql.Response{Data: []byte(\"null\"), Errors: ec.Errors})\n\t\t}\n\n\t\tvar buf bytes.Buffer\n\t\treturn func() *graphql.Response {\n\t\t\tbuf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {\n\t\t\t\tbuf.Reset()\n\t\t\t\tdata := next()\n\n\t\t\t\tif data == nil {\n\t\t\t\t\treturn nil\n\t\t\t\t}\n\t\t\t\tdata.MarshalGQL(&buf)\n\t\t\t\treturn buf.Bytes()\n\t\t\t})\n\n\t\t\tif buf == nil {\n\t\t\t\treturn nil\n\t\t\t}\n\n\t\t\treturn &graphql.Response{\n\t\t\t\tData:       buf,\n\t\t\t\tErrors:     ec.Errors,\n\t\t\t\tExtensions: ec.Extensions,\n\t\t\t}\n\t\t}\n\t{{- else }}\n\t\treturn graphql.OneShot(graphql.ErrorResponse(ctx, \"subscriptions are not supported\"))\n\t{{- end }}\n}\n\ntype executionContext struct {\n\t*graphql.RequestContext\n\t*executableSchema\n}\n\nfunc (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {\n\tec := executionContext{graphql.GetRequestContext(ctx), e}\n\tif ec.ConcurrencyLimit == 0 {\n\t\tec.ConcurrencyLimit = e.concurrencyLimit\n\t}\n\treturn ec\n}\n{{- end }}\n\n{{- range $object := .Objects }}\n\t{{- if $.HasType $object.GQLType }}\n\t{{ template \"object.gotpl\" $object }}\n\n\t{{- range $field := $object.Fields }}\n\t\t{{ template \"field.gotpl\" $field }}\n\t{{ end }}\n\t{{- end }}\n{{- end}}\n\n{{- range $interface := .Interfaces }}\n\t{{- if $.HasType $interface.GQLType }}\n\t{{ template \"interface.gotpl\" $interface }}\n\t{{- end }}\n{{- end }}\n\n{{- range $input := .Inputs }}\n\t{{- if $.HasType $input.GQLType }}\n\t{{ template \"input.gotpl\" $input }}\n\t{{- end }}\n{{- end }}\n\n{{- range $enum := .BoundEnums }}\n\t{{- if $.HasType $enum.GQLType }}\n\t{{ template \"enum.gotpl\" $enum }}\n\t{{- end }}\n{{- end }}\n\n{{ if $.IsRoot -}}\nfunc (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) (ret interface{}) {\n\tdefer func() {\n\t\tif r := recover(); r != nil {\n\t\t\tec.Error(ctx, ec.Recover(ctx, r))\n\t\t\tret = nil\n\t\t}\n\t}()\n\trctx := graphql.GetResolverContext(ctx)\n\ttimeout := ec.ResolverTimeout\n\tfor _, d := range rctx.Field.Definition.Directives {\n\t\tif d.Name == \"timeout\" {\n\t\t\tms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)[\"ms\"])\n\t\t\tif err != nil {\n\t\t\t\tec.Error(ctx, err)\n\t\t\t\treturn nil\n\t\t\t}\n\t\t\ttimeout = time.Duration(ms) * time.Millisecond\n\t\t\tcontinue\n\t\t}\n\t\tvar err error\n\t\tnext, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)\n\t\tif err != nil {\n\t\t\tec.Error(ctx, err)\n\t\t\treturn nil\n\t\t}\n\t}\n\t{{- if or .HasEnumValueDirectives .HasObjectDirectives }}\n\t// directives on enum values used in the arguments and on the object run outside of those on the field\n\t{{- end }}\n\t{{- if .HasEnumValueDirectives }}\n\tfor _, d := range graphql.EnumValueDirectives(parsedSchema, rctx.Field, ec.Variables) {\n\t\tvar err error\n\t\tnext, err = ec.directive(obj, d, ast.LocationEnumValue, next)\n\t\tif err != nil {\n\t\t\tec.Error(ctx, err)\n\t\t\treturn nil\n\t\t}\n\t}\n\t{{- end }}\n\t{{- if .HasObjectDirectives }}\n\tfor _, d := range parsedSchema.Types[rctx.Object].Directives {\n\t\tvar err error\n\t\tnext, err = ec.directive(obj, d, ast.LocationObject, next)\n\t\tif err != nil {\n\t\t\tec.Error(ctx, err)\n\t\t\treturn nil\n\t\t}\n\t}\n\t{{- end }}\n\t// directives in the query run outside of those in the schema\n\tfor _, d := range rctx.Field.QueryDirectives() {\n\t\tvar err error\n\t\tnext, err = ec.directive(obj, d, d.Location, next)\n\t\tif err != nil {\n\t\t\tec.Error(ctx, err)\n\t\t\treturn nil\n\t\t}\n\t}\n\tif timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {\n\t\tctx = graphql.WithFieldTimeout(ctx, timeout)\n\t}\n\tres, err := ec.ResolverMiddleware(ctx, next)\n\tif err != nil {\n\t\tec.Error(ctx, err)\n\t\treturn nil\n\t}\n\treturn res\n}\n\n// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one\nfunc (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {\n\tswitch d.Name {\n\t{{- range $directive := .Directives }}\n\tcase \"{{$directive.Name}}\":\n\t\tif ec.directives.{{$directive.Name|ucFirst}} != nil {\n\t\t\t{{- if $directive.Args }}\n\t\t\t\trawArgs := d.ArgumentMap(ec.Variables)\n\t\t\t\targs, err := {{ $directive.ArgsFunc }}(rawArgs)\n\t\t\t\tif err != nil {\n\t\t\t\t\treturn nil, err\n\t\t\t\t}\n\t\t\t{{- end }}\n\t\t\tn := next\n\t\t\treturn func(ctx context.Context) (interface{}, error) {\n\t\t\t\tctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})\n\t\t\t\treturn ec.directives.{{$directive.Name|ucFirst}}({{$directive.CallArgs}})\n\t\t\t}, nil\n\t\t}\n\t{{- end }}\n\t}\n\treturn next, nil\n}\n\n{{- with .Relay }}\n\t{{ template \"relay.gotpl\" . }}\n{{- end }}\n\n{{- with .Federation }}\n\t{{ template \"federation.gotpl\" . }}\n{{- end }}\n\n// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value\nfunc (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {\n\tnext := func(ctx context.Context) (interface{}, error) {\n\t\treturn value, nil\n\t}\n\tfor _, d := range directives {\n\t\tvar err error\n\t\tnext, err = ec.directive(obj, d, location, next)\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t}\n\treturn next(ctx)\n}\n\nfunc (ec *executionContext) introspectSchema() (*introspection.Schema, error) {\n\tif ec.DisableIntrospection {\n\t\treturn nil, errors.New(\"introspection disabled\")\n\t}\n\treturn introspection.WrapSchema(parsedSchema), nil\n}\n\nfunc (ec *executionContext) introspectType(name string) (*introspection.Type, error) {\n\tif ec.DisableIntrospection {\n\t\treturn nil, errors.New(\"introspection disabled\")\n\t}\n\treturn introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil\n}\n\nvar parsedSchema = gqlparser.MustLoadSchema(\n\t{{- range $filename, $schema := .SchemaRaw }}\n\t\t&ast.Source{Name: {{$filename|quote}}, Input: {{$schema|rawQuote}}},\n\t{{- end }}\n)\n{{- end }}\n",
+	"input.gotpl":       "\t{{- if .IsMarshaled }}\n\t{{ template \"constraints.gotpl\" .Fields }}\n\n\tfunc Unmarshal{{ .GQLType }}(v interface{}) ({{.FullName}}, error) {\n\t\tvar it {{.FullName}}\n\t\tvar asMap = v.(map[string]interface{})\n\t\t{{- if .HasIsSet }}\n\t\t\tit.IsSet = make(map[string]bool, len(asMap))\n\t\t\tfor k := range asMap {\n\t\t\t\tit.IsSet[k] = true\n\t\t\t}\n\t\t{{- end }}\n\t\t{{ range $field := .Fields}}\n\t\t\t{{- if $field.Default}}\n\t\t\t\tif _, present := asMap[{{$field.GQLName|quote}}] ; !present {\n\t\t\t\t\tasMap[{{$field.GQLName|quote}}] = {{ $field.Default | dump }}\n\t\t\t\t}\n\t\t\t{{- end}}\n\t\t{{- end }}\n\n\t\tfor k, v := range asMap {\n\t\t\tswitch k {\n\t\t\t{{- range $field := .Fields }}\n\t\t\tcase {{$field.GQLName|quote}}:\n\t\t\t\tvar err error\n\t\t\t\t{{ $field.Unmarshal (print \"it.\" $field.GoFieldName) \"v\" }}\n\t\t\t\tif err != nil {\n\t\t\t\t\treturn it, {{ if $field.HasConstraints }}graphql.PrefixConstraintPath(err, k){{ else }}err{{ end }}\n\t\t\t\t}\n\t\t\t\t{{- with $field.Constraint }}\n\t\t\t\t\tif err := {{ .VarName }}.Check(k, it.{{ $field.GoFieldName }}); err != nil {\n\t\t\t\t\t\treturn it, err\n\t\t\t\t\t}\n\t\t\t\t{{- end }}\n\t\t\t{{- end }}\n\t\t\t}\n\t\t}\n\n\t\treturn it, nil\n\t}\n\n\t{{- if .HasInputDirectives }}\n\n\tfunc (ec *executionContext) inputDirectives{{ .GQLType }}(ctx context.Context, it *{{.FullName}}) error {\n\t\t{{- range $field := .Fields }}\n\t\t\t{{- if or $field.Directives $field.HasInputDirectives }}\n\t\t\t\t{{ $field.FieldDirectives }}\n\t\t\t{{- end }}\n\t\t{{- end }}\n\t\treturn nil\n\t}\n\t{{- end }}\n\t{{- end }}\n",
+	"interface.gotpl":   "{{- $interface := . }}\n\nfunc (ec *executionContext) _{{$interface.GQLType}}(ctx context.Context, sel ast.SelectionSet, obj *{{$interface.FullName}}) graphql.Marshaler {\n\tswitch obj := (*obj).(type) {\n\tcase nil:\n\t\treturn graphql.Null\n\t{{- range $implementor := $interface.Implementors }}\n\t\t{{- if $implementor.ValueReceiver }}\n\t\t\tcase {{$implementor.FullName}}:\n\t\t\t\treturn ec._{{$implementor.GQLType}}(ctx, sel, &obj)\n\t\t{{- end}}\n\t\tcase *{{$implementor.FullName}}:\n\t\t\treturn ec._{{$implementor.GQLType}}(ctx, sel, obj)\n\t{{- end }}\n\tdefault:\n\t\tpanic(fmt.Errorf(\"unexpected type %T\", obj))\n\t}\n}\n",
+	"loaders.gotpl":     "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"time\"  }}\n\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n)\n\n// LoadersConfig provides the fetch functions and batching options for every loader in the registry.\ntype LoadersConfig struct {\n{{- range $loader := .Loaders }}\n\t{{ $loader.Name }} {{ $loader.Name }}LoaderConfig\n{{- end }}\n}\n\n// Loaders is a registry of dataloaders, a new registry should be created for every request so that\n// cached values are never shared between users.\ntype Loaders struct {\n{{- range $loader := .Loaders }}\n\t{{ $loader.Name }} *{{ $loader.Name }}Loader\n{{- end }}\n}\n\n// NewLoaders creates a new set of loaders. Fetch functions will be called with ctx.\nfunc NewLoaders(ctx context.Context, cfg LoadersConfig) *Loaders {\n\treturn &Loaders{\n\t{{- range $loader := .Loaders }}\n\t\t{{ $loader.Name }}: New{{ $loader.Name }}Loader(ctx, cfg.{{ $loader.Name }}),\n\t{{- end }}\n\t}\n}\n\ntype loadersCtxKey struct{}\n\n// WithLoaders attaches a loader registry to the context.\nfunc WithLoaders(ctx context.Context, loaders *Loaders) context.Context {\n\treturn context.WithValue(ctx, loadersCtxKey{}, loaders)\n}\n\n// GetLoaders returns the loader registry for the current request, or nil if LoadersMiddleware is not in use.\nfunc GetLoaders(ctx context.Context) *Loaders {\n\tloaders, _ := ctx.Value(loadersCtxKey{}).(*Loaders)\n\treturn loaders\n}\n\n// LoadersMiddleware creates a new loader registry for every request, install it with handler.RequestMiddleware.\nfunc LoadersMiddleware(cfg LoadersConfig) graphql.RequestMiddleware {\n\treturn func(ctx context.Context, next func(ctx context.Context) []byte) []byte {\n\t\treturn next(WithLoaders(ctx, NewLoaders(ctx, cfg)))\n\t}\n}\n\n{{- range $loader := .Loaders }}\n{{ $batch := print (lcFirst $loader.Name) \"Batch\" }}\n// {{ $loader.Name }}LoaderConfig captures the config to create a new {{ $loader.Name }}Loader\ntype {{ $loader.Name }}LoaderConfig struct {\n\t// Fetch is a method that provides the data for the loader. It must return a value for every key,\n\t// in the same order as the keys, or a single error for the whole batch.\n\tFetch func(ctx context.Context, keys []{{ $loader.Key.FullName }}) ([]{{ $loader.Result }}, []error)\n\n\t// Wait is how long to wait for more keys before sending a batch, defaults to {{ $loader.Wait }}\n\tWait time.Duration\n\n\t// MaxBatch will limit the maximum number of keys to send in one batch, {{ if $loader.MaxBatch }}defaults to {{ $loader.MaxBatch }}{{ else }}0 = no limit{{ end }}\n\tMaxBatch int\n}\n\n// New{{ $loader.Name }}Loader creates a new {{ $loader.Name }}Loader given a fetch, wait, and maxBatch\nfunc New{{ $loader.Name }}Loader(ctx context.Context, cfg {{ $loader.Name }}LoaderConfig) *{{ $loader.Name }}Loader {\n\tif cfg.Wait == 0 {\n\t\tcfg.Wait = time.Duration({{ $loader.WaitNanos }})\n\t}\n\tif cfg.MaxBatch == 0 {\n\t\tcfg.MaxBatch = {{ $loader.MaxBatch }}\n\t}\n\treturn &{{ $loader.Name }}Loader{\n\t\tctx:      ctx,\n\t\tfetch:    cfg.Fetch,\n\t\twait:     cfg.Wait,\n\t\tmaxBatch: cfg.MaxBatch,\n\t}\n}\n\n// {{ $loader.Name }}Loader batches and caches requests\ntype {{ $loader.Name }}Loader struct {\n\t// the context passed to fetch\n\tctx context.Context\n\n\t// this method provides the data for the loader\n\tfetch func(ctx context.Context, keys []{{ $loader.Key.FullName }}) ([]{{ $loader.Result }}, []error)\n\n\t// how long to wait before sending a batch\n\twait time.Duration\n\n\t// this will limit the maximum number of keys to send in one batch, 0 = no limit\n\tmaxBatch int\n\n\t// INTERNAL\n\n\t// lazily created cache\n\tcache map[{{ $loader.Key.FullName }}]{{ $loader.Result }}\n\n\t// the current batch. keys will continue to be collected until timeout is hit,\n\t// then everything will be sent to the fetch method and out to the listeners\n\tbatch *{{ $batch }}\n\n\t// mutex to prevent races\n\tmu sync.Mutex\n}\n\ntype {{ $batch }} struct {\n\tkeys    []{{ $loader.Key.FullName }}\n\tdata    []{{ $loader.Result }}\n\terror   []error\n\tclosing bool\n\tdone    chan struct{}\n}\n\n// Load a {{ $loader.Name }} by key, batching and caching will be applied automatically\nfunc (l *{{ $loader.Name }}Loader) Load(key {{ $loader.Key.FullName }}) ({{ $loader.Result }}, error) {\n\treturn l.LoadThunk(key)()\n}\n\n// LoadThunk returns a function that when called will block waiting for a {{ $loader.Name }}.\n// This method should be used if you want one goroutine to make requests to many\n// different data loaders without blocking until the thunk is called.\nfunc (l *{{ $loader.Name }}Loader) LoadThunk(key {{ $loader.Key.FullName }}) func() ({{ $loader.Result }}, error) {\n\tl.mu.Lock()\n\tif it, ok := l.cache[key]; ok {\n\t\tl.mu.Unlock()\n\t\treturn func() ({{ $loader.Result }}, error) {\n\t\t\treturn it, nil\n\t\t}\n\t}\n\tif l.batch == nil {\n\t\tl.batch = &{{ $batch }}{done: make(chan struct{})}\n\t}\n\tbatch := l.batch\n\tpos := batch.keyIndex(l, key)\n\tl.mu.Unlock()\n\n\treturn func() ({{ $loader.Result }}, error) {\n\t\t<-batch.done\n\n\t\tvar data {{ $loader.Result }}\n\t\tif pos < len(batch.data) {\n\t\t\tdata = batch.data[pos]\n\t\t}\n\n\t\tvar err error\n\t\t// its convenient to be able to return a single error for everything\n\t\tif len(batch.error) == 1 {\n\t\t\terr = batch.error[0]\n\t\t} else if batch.error != nil {\n\t\t\terr = batch.error[pos]\n\t\t}\n\n\t\tif err == nil {\n\t\t\tl.mu.Lock()\n\t\t\tl.unsafeSet(key, data)\n\t\t\tl.mu.Unlock()\n\t\t}\n\n\t\treturn data, err\n\t}\n}\n\n// LoadAll fetches many keys at once. It will be broken into appropriate sized\n// sub batches depending on how the loader is configured\nfunc (l *{{ $loader.Name }}Loader) LoadAll(keys []{{ $loader.Key.FullName }}) ([]{{ $loader.Result }}, []error) {\n\tresults := make([]func() ({{ $loader.Result }}, error), len(keys))\n\n\tfor i, key := range keys {\n\t\tresults[i] = l.LoadThunk(key)\n\t}\n\n\tvalues := make([]{{ $loader.Result }}, len(keys))\n\terrors := make([]error, len(keys))\n\tfor i, thunk := range results {\n\t\tvalues[i], errors[i] = thunk()\n\t}\n\treturn values, errors\n}\n\n// Prime the cache with the provided key and value. If the key already exists, no change is made\n// and false is returned.\n// (To forcefully prime the cache, clear the key first with loader.Clear(key) or use ForcePrime.)\nfunc (l *{{ $loader.Name }}Loader) Prime(key {{ $loader.Key.FullName }}, value {{ $loader.Result }}) bool {\n\tl.mu.Lock()\n\tvar found bool\n\tif _, found = l.cache[key]; !found {\n\t\tl.unsafeSet(key, l.clone(value))\n\t}\n\tl.mu.Unlock()\n\treturn !found\n}\n\n// PrimeAll primes the cache with many values at once, skipping any keys that already exist.\n// keys and values must be the same length.\nfunc (l *{{ $loader.Name }}Loader) PrimeAll(keys []{{ $loader.Key.FullName }}, values []{{ $loader.Result }}) {\n\tl.mu.Lock()\n\tfor i, key := range keys {\n\t\tif _, found := l.cache[key]; !found {\n\t\t\tl.unsafeSet(key, l.clone(values[i]))\n\t\t}\n\t}\n\tl.mu.Unlock()\n}\n\n// ForcePrime sets the value for key in the cache, replacing any existing value.\nfunc (l *{{ $loader.Name }}Loader) ForcePrime(key {{ $loader.Key.FullName }}, value {{ $loader.Result }}) {\n\tl.mu.Lock()\n\tl.unsafeSet(key, l.clone(value))\n\tl.mu.Unlock()\n}\n\n// Clear the value at key from the cache, if it exists\nfunc (l *{{ $loader.Name }}Loader) Clear(key {{ $loader.Key.FullName }}) {\n\tl.mu.Lock()\n\tdelete(l.cache, key)\n\tl.mu.Unlock()\n}\n\n// clone makes a copy when writing to the cache, its easy to pass a pointer in from a loop var\n// and end up with the whole cache pointing to the same value.\nfunc (l *{{ $loader.Name }}Loader) clone(value {{ $loader.Result }}) {{ $loader.Result }} {\n\t{{- if $loader.Slice }}\n\t\tif value == nil {\n\t\t\treturn nil\n\t\t}\n\t\tcpy := make({{ $loader.Result }}, len(value))\n\t\tcopy(cpy, value)\n\t\treturn cpy\n\t{{- else if $loader.Pointer }}\n\t\tif value == nil {\n\t\t\treturn nil\n\t\t}\n\t\tcpy := *value\n\t\treturn &cpy\n\t{{- else }}\n\t\treturn value\n\t{{- end }}\n}\n\nfunc (l *{{ $loader.Name }}Loader) unsafeSet(key {{ $loader.Key.FullName }}, value {{ $loader.Result }}) {\n\tif l.cache == nil {\n\t\tl.cache = map[{{ $loader.Key.FullName }}]{{ $loader.Result }}{}\n\t}\n\tl.cache[key] = value\n}\n\n// keyIndex will return the location of the key in the batch, if its not found\n// it will add the key to the batch\nfunc (b *{{ $batch }}) keyIndex(l *{{ $loader.Name }}Loader, key {{ $loader.Key.FullName }}) int {\n\tfor i, existingKey := range b.keys {\n\t\tif key == existingKey {\n\t\t\treturn i\n\t\t}\n\t}\n\n\tpos := len(b.keys)\n\tb.keys = append(b.keys, key)\n\tif pos == 0 {\n\t\tgo b.startTimer(l)\n\t}\n\n\tif l.maxBatch != 0 && pos >= l.maxBatch-1 {\n\t\tif !b.closing {\n\t\t\tb.closing = true\n\t\t\tl.batch = nil\n\t\t\tgo b.end(l)\n\t\t}\n\t}\n\n\treturn pos\n}\n\nfunc (b *{{ $batch }}) startTimer(l *{{ $loader.Name }}Loader) {\n\ttime.Sleep(l.wait)\n\tl.mu.Lock()\n\n\t// we must have hit a batch limit and are already finalizing this batch\n\tif b.closing {\n\t\tl.mu.Unlock()\n\t\treturn\n\t}\n\n\tl.batch = nil\n\tl.mu.Unlock()\n\n\tb.end(l)\n}\n\nfunc (b *{{ $batch }}) end(l *{{ $loader.Name }}Loader) {\n\tb.data, b.error = l.fetch(l.ctx, b.keys)\n\tclose(b.done)\n}\n{{- end }}\n",
+	"mock.gotpl":        "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"math/rand\"  }}\n\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n)\n\n// {{ .MockType }} returns fake data for every field, so the schema can be served before the real resolvers exist.\n// The same Seed always returns the same data for a query.\ntype {{ .MockType }} struct {\n\tSeed int64\n}\n\nvar _ {{ .ResolverRoot.FullName }} = &{{ .MockType }}{}\n\n// mockDepth is how deep mocked structs are filled in, so that types that refer to themselves don't recurse forever\nconst mockDepth = {{ .Depth }}\n\n{{ range $object := .Objects -}}\nfunc (r *{{ $.MockType }}) {{ $object.GQLType }}() {{ $object.ResolverInterface.FullName }} {\n\treturn &{{ $.ResolverImplementation $object }}{r}\n}\n{{ end }}\n\n{{- range $object := .Objects }}\n\ntype {{ $.ResolverImplementation $object }} struct{ *{{ $.MockType }} }\n\n{{ range $field := $object.Fields -}}\n{{- if $field.IsResolver }}\nfunc (r *{{ $.ResolverImplementation $object }}) {{ $.Declaration $field }} {\n\t{{ $.ResolverBody $field }}\n}\n\n{{ end }}\n{{- end }}\n{{- end }}\n\n{{- range $model := .Models }}\n\nfunc (r *{{ $.MockType }}) mock{{ $model.GQLType }}(rnd *rand.Rand, depth int) (res {{ $model.FullName }}, err error) {\n\tif depth > mockDepth {\n\t\treturn\n\t}\n\t{{ $.ModelFields $model }}\n\treturn\n}\n{{- end }}\n",
+	"models.gotpl":      "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"fmt\"  }}\n\t{{ reserveImport \"io\"  }}\n\t{{ reserveImport \"strconv\"  }}\n\t{{ reserveImport \"time\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"errors\"  }}\n\t{{ reserveImport \"bytes\"  }}\n\t{{ reserveImport \"encoding/json\"  }}\n\n\t{{ reserveImport \"github.com/vektah/gqlparser\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser/ast\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql/introspection\" }}\n)\n\n{{ range $model := .Models }}\n\t{{with .Description}} {{.|prefixLines \"// \"}} {{end}}\n\t{{- if .IsInterface }}\n\t\ttype {{.GoType}} interface {\n\t\t\tIs{{.GoType}}()\n\t\t\t{{- range $getter := .Getters }}\n\t\t\t\t{{- with .Description}}\n\t\t\t\t\t{{.|prefixLines \"// \"}}\n\t\t\t\t{{- end}}\n\t\t\t\t{{ $getter.GoName }}() {{ $getter.Signature }}\n\t\t\t{{- end }}\n\t\t}\n\t{{- else }}\n\t\ttype {{.GoType}} struct {\n\t\t\t{{- range $field := .Fields }}\n\t\t\t\t{{- with .Description}}\n\t\t\t\t\t{{.|prefixLines \"// \"}}\n\t\t\t\t{{- end}}\n\t\t\t\t{{- if $field.GoFieldName }}\n\t\t\t\t\t{{ $field.GoFieldName }} {{$field.Signature}} `{{$field.Tags}}`\n\t\t\t\t{{- else }}\n\t\t\t\t\t{{ $field.GoFKName }} {{$field.GoFKType}}\n\t\t\t\t{{- end }}\n\t\t\t{{- end }}\n\t\t\t{{- if .HasIsSet }}\n\t\t\t\t// IsSet records which fields were given, including those that were explicitly null\n\t\t\t\tIsSet map[string]bool `json:\"-\"`\n\t\t\t{{- end }}\n\t\t}\n\n\t\t{{- range $iface := .Implements }}\n\t\t\tfunc ({{$model.GoType}}) Is{{$iface.GoType}}() {}\n\t\t{{- end }}\n\n\t\t{{- range $getter := .Getters }}\n\t\t\tfunc ({{ receiver $model.GoType }} {{$model.GoType}}) {{ $getter.GoName }}() {{ $getter.Signature }} { return {{ receiver $model.GoType }}.{{ $getter.GoFieldName }} }\n\t\t{{- end }}\n\n\t{{- end }}\n{{- end}}\n\n{{ range $enum := .Enums }}\n\t{{with .Description}}{{.|prefixLines \"// \"}} {{end}}\n\ttype {{.GoType}} string\n\tconst (\n\t{{- range $value := .Values}}\n\t\t{{- with .Description}}\n\t\t\t{{.|prefixLines \"// \"}}\n\t\t{{- end}}\n\t\t{{$enum.GoType}}{{ .Name|toCamel }} {{$enum.GoType}} = {{.Name|quote}}\n\t{{- end }}\n\t)\n\n\tvar All{{.GoType}} = []{{.GoType}}{\n\t{{- range $value := .Values}}\n\t\t{{$enum.GoType}}{{ .Name|toCamel }},\n\t{{- end }}\n\t}\n\n\tfunc (e {{.GoType}}) IsValid() bool {\n\t\tswitch e {\n\t\tcase {{ range $index, $element := .Values}}{{if $index}},{{end}}{{ $enum.GoType }}{{ $element.Name|toCamel }}{{end}}:\n\t\t\treturn true\n\t\t}\n\t\treturn false\n\t}\n\n\tfunc (e {{.GoType}}) String() string {\n\t\treturn string(e)\n\t}\n\n\tfunc (e *{{.GoType}}) UnmarshalGQL(v interface{}) error {\n\t\tstr, ok := v.(string)\n\t\tif !ok {\n\t\t\treturn fmt.Errorf(\"enums must be strings\")\n\t\t}\n\n\t\t*e = {{.GoType}}(str)\n\t\tif !e.IsValid() {\n\t\t\treturn fmt.Errorf(\"%s is not a valid {{.GQLType}}, expected one of {{.ValueNames}}\", str)\n\t\t}\n\t\treturn nil\n\t}\n\n\tfunc (e {{.GoType}}) MarshalGQL(w io.Writer) {\n\t\tfmt.Fprint(w, strconv.Quote(e.String()))\n\t}\n\n\tfunc (e *{{.GoType}}) UnmarshalJSON(b []byte) error {\n\t\tvar str *string\n\t\tif err := json.Unmarshal(b, &str); err != nil {\n\t\t\treturn fmt.Errorf(\"{{.GQLType}} must be a json string\")\n\t\t}\n\t\tif str == nil {\n\t\t\treturn nil\n\t\t}\n\t\treturn e.UnmarshalGQL(*str)\n\t}\n\n\tfunc (e {{.GoType}}) MarshalJSON() ([]byte, error) {\n\t\tvar buf bytes.Buffer\n\t\te.MarshalGQL(&buf)\n\t\treturn buf.Bytes(), nil\n\t}\n\n{{- end }}\n",
+	"object.gotpl":      "{{ $object := . }}\n\nvar {{ $object.GQLType|lcFirst}}Implementors = {{$object.Implementors}}\n\n// nolint: gocyclo, errcheck, gas, goconst\n{{- if .Stream }}\nfunc (ec *executionContext) _{{$object.GQLType}}(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {\n\tfields := graphql.CollectFields(ctx, sel, {{$object.GQLType|lcFirst}}Implementors)\n\tctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{\n\t\tObject: {{$object.GQLType|quote}},\n\t})\n\tif len(fields) != 1 {\n\t\tec.Errorf(ctx, \"must subscribe to exactly one stream\")\n\t\treturn nil\n\t}\n\n\tswitch fields[0].Name {\n\t{{- range $field := $object.Fields }}\n\tcase \"{{$field.GQLName}}\":\n\t\treturn ec._{{$object.GQLType}}_{{$field.GQLName}}(ctx, fields[0])\n\t{{- end }}\n\tdefault:\n\t\tpanic(\"unknown field \" + strconv.Quote(fields[0].Name))\n\t}\n}\n{{- else }}\nfunc (ec *executionContext) _{{$object.GQLType}}(ctx context.Context, sel ast.SelectionSet{{if not $object.Root}}, obj *{{$object.FullName}} {{end}}) graphql.Marshaler {\n\tfields := graphql.CollectFields(ctx, sel, {{$object.GQLType|lcFirst}}Implementors)\n\t{{if $object.Root}}\n\t\tctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{\n\t\t\tObject: {{$object.GQLType|quote}},\n\t\t})\n\t{{end}}\n\n\t{{if $object.IsConcurrent}} var wg sync.WaitGroup {{end}}\n\tout := graphql.NewOrderedMap(len(fields))\n\tinvalid := false\n\tfor i, field := range fields {\n\t\tout.Keys[i] = field.Alias\n\n\t\tswitch field.Name {\n\t\tcase \"__typename\":\n\t\t\tout.Values[i] = graphql.MarshalString({{$object.GQLType|quote}})\n\t\t{{- range $field := $object.Fields }}\n\t\tcase \"{{$field.GQLName}}\":\n\t\t\t{{- if $field.IsConcurrent }}\n\t\t\t\ti, field := i, field\n\t\t\t\twg.Add(1)\n\t\t\t\tec.Go(func() {\n\t\t\t{{- end }}\n\t\t\t\tout.Values[i] = ec._{{$object.GQLType}}_{{$field.GQLName}}(ctx, field{{if not $object.Root}}, obj{{end}})\n\t\t\t\t{{- if $field.ASTType.NonNull }}\n\t\t\t\t\tif out.Values[i] == graphql.Null {\n\t\t\t\t\t\tinvalid = true\n\t\t\t\t\t}\n\t\t\t\t{{- end }}\n\t\t\t{{- if $field.IsConcurrent }}\n\t\t\t\t\twg.Done()\n\t\t\t\t})\n\t\t\t{{- end }}\n\t\t{{- end }}\n\t\tdefault:\n\t\t\tpanic(\"unknown field \" + strconv.Quote(field.Name))\n\t\t}\n\t}\n\t{{if $object.IsConcurrent}} wg.Wait() {{end}}\n\tif invalid { return graphql.Null }\n\treturn out\n}\n{{- end }}\n",
+	"relay.gotpl":       "{{- $relay := . }}\n\n// resolveNode fetches a Node by its global id, using the type name encoded in the id to pick the NodeResolver\nfunc (ec *executionContext) resolveNode(ctx context.Context, id string) ({{ $relay.Node.Signature }}, error) {\n\ttypename, localID, err := graphql.DecodeGlobalID(id)\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\tswitch typename {\n\t{{- range $field := $relay.Resolver.Fields }}\n\tcase {{ $field.GQLName|quote }}:\n\t\tnode, err := ec.resolvers.Node().{{ $field.GoNameExported }}(ctx, localID)\n\t\tif node == nil {\n\t\t\treturn nil, err\n\t\t}\n\t\treturn node, err\n\t{{- end }}\n\t}\n\treturn nil, fmt.Errorf(\"%s does not implement Node\", typename)\n}\n\n// resolveNodes fetches each of the Nodes, ids that don't match anything are null. An id that can't be resolved is\n// null too, with its error reported at the index of the id.\nfunc (ec *executionContext) resolveNodes(ctx context.Context, ids []string) ({{ $relay.Nodes.Signature }}, error) {\n\tnodes := make({{ $relay.Nodes.Signature }}, len(ids))\n\tfor i, id := range ids {\n\t\tnode, err := ec.resolveNode(ctx, id)\n\t\tif err != nil {\n\t\t\tindex := i\n\t\t\tec.Error(graphql.WithResolverContext(ctx, &graphql.ResolverContext{Index: &index}), err)\n\t\t\tcontinue\n\t\t}\n\t\tif node != nil {\n\t\t\tnodes[i] = &node\n\t\t}\n\t}\n\treturn nodes, nil\n}\n",
+	"resolver.gotpl":    "package {{ .PackageName }}\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\"  }}\n\t{{ reserveImport \"fmt\"  }}\n\t{{ reserveImport \"io\"  }}\n\t{{ reserveImport \"strconv\"  }}\n\t{{ reserveImport \"time\"  }}\n\t{{ reserveImport \"sync\"  }}\n\t{{ reserveImport \"errors\"  }}\n\t{{ reserveImport \"bytes\"  }}\n\n\t{{ reserveImport \"github.com/99designs/gqlgen/handler\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser\" }}\n\t{{ reserveImport \"github.com/vektah/gqlparser/ast\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/graphql/introspection\" }}\n)\n\n{{ $.ResolverTypeDecl }}\n\n{{ range $object := .Objects -}}\n\t{{- if $.HasObject $object -}}\n\t\t{{ $.Implementation $.ResolverType $object.GQLType (print $object.GQLType \"() \" $object.ResolverInterface.FullName) (print \"return &\" $object.ResolverImplementation \"{r}\") }}\n\t{{ end -}}\n{{ end }}\n\n{{ range $object := .Objects -}}\n\t{{- if $.HasObject $object -}}\n\t\t{{ $.ObjectResolverDecl $object }}\n\n\t{{ end -}}\n\t{{- range $field := $object.Fields -}}\n\t\t{{- if $.HasField $field -}}\n\t\t{{ $.Implementation $object.ResolverImplementation $field.GoNameExported $field.ShortResolverDeclaration \"panic(\\\"not implemented\\\")\" }}\n\t\t{{ end -}}\n\t{{- end -}}\n{{ end }}\n\n{{- with $.RemainingSource }}\n{{ . }}\n{{ end }}\n\n{{- with $.UnusedSource }}\n{{ . }}\n{{ end }}\n",
+	"server.gotpl":      "package main\n\nimport (\n\t%%%IMPORTS%%%\n\n\t{{ reserveImport \"context\" }}\n\t{{ reserveImport \"log\" }}\n\t{{ reserveImport \"net/http\" }}\n\t{{ reserveImport \"os\" }}\n\t{{ reserveImport \"github.com/99designs/gqlgen/handler\" }}\n)\n\nconst defaultPort = \"8080\"\n\nfunc main() {\n\tport := os.Getenv(\"PORT\")\n\tif port == \"\" {\n\t\tport = defaultPort\n\t}\n\n\thttp.Handle(\"/\", handler.Playground(\"GraphQL playground\", \"/query\"))\n\thttp.Handle(\"/query\", handler.GraphQL({{ lookupImport .ExecPackageName }}.NewExecutableSchema({{ lookupImport .ExecPackageName}}.Config{Resolvers: &{{ lookupImport .ResolverPackageName}}.Resolver{}})))\n\n\tlog.Printf(\"connect to http://localhost:%s/ for GraphQL playground\", port)\n\tlog.Fatal(http.ListenAndServe(\":\" + port, nil))\n}\n",
 }
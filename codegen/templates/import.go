@@ -2,7 +2,6 @@ package templates
 
 import (
 	"fmt"
-	"go/build"
 	"strconv"
 
 	"github.com/99designs/gqlgen/internal/gopath"
@@ -48,7 +47,7 @@ func (s *Imports) Reserve(path string, aliases ...string) string {
 		return ""
 	}
 
-	pkg, err := build.Default.Import(path, s.destDir, 0)
+	pkg, err := gopath.Import(path, s.destDir, 0)
 	if err != nil {
 		panic(err)
 	}
@@ -91,7 +90,7 @@ func (s *Imports) Lookup(path string) string {
 		return existing.Alias
 	}
 
-	pkg, err := build.Default.Import(path, s.destDir, 0)
+	pkg, err := gopath.Import(path, s.destDir, 0)
 	if err != nil {
 		panic(err)
 	}
@@ -26,6 +26,7 @@ func Run(name string, tpldata interface{}) (*bytes.Buffer, error) {
 	t := template.New("").Funcs(template.FuncMap{
 		"ucFirst":       ucFirst,
 		"lcFirst":       lcFirst,
+		"receiver":      receiver,
 		"quote":         strconv.Quote,
 		"rawQuote":      rawQuote,
 		"toCamel":       ToCamel,
@@ -70,6 +71,14 @@ func lcFirst(s string) string {
 	return string(r)
 }
 
+// receiver is the name of a method receiver for the type, its first letter in lower case
+func receiver(typ string) string {
+	for _, r := range typ {
+		return string(unicode.ToLower(r))
+	}
+	return ""
+}
+
 func isDelimiter(c rune) bool {
 	return c == '-' || c == '_' || unicode.IsSpace(c)
 }
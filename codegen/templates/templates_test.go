@@ -13,3 +13,9 @@ func TestToUpper(t *testing.T) {
 	require.Equal(t, "ToCamel", ToCamel("ToCamel"))
 	require.Equal(t, "ToCamel", ToCamel("to-camel"))
 }
+
+func TestReceiver(t *testing.T) {
+	require.Equal(t, "u", receiver("User"))
+	require.Equal(t, "u", receiver("userInput"))
+	require.Equal(t, "", receiver(""))
+}
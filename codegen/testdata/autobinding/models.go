@@ -0,0 +1,6 @@
+package autobinding
+
+type User struct {
+	ID   int
+	Name string
+}
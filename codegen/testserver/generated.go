@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/introspection"
 	"github.com/99designs/gqlgen/codegen/testserver/invalid-packagename"
@@ -21,9 +22,10 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
-		resolvers:  cfg.Resolvers,
-		directives: cfg.Directives,
-		complexity: cfg.Complexity,
+		resolvers:        cfg.Resolvers,
+		directives:       cfg.Directives,
+		complexity:       cfg.Complexity,
+		concurrencyLimit: cfg.ConcurrencyLimit,
 	}
 }
 
@@ -31,6 +33,9 @@ type Config struct {
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
+	// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,
+	// it is used when the request context doesn't set its own limit.
+	ConcurrencyLimit int
 }
 
 type ResolverRoot interface {
@@ -42,9 +47,20 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
+	Audit func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+
+	HasRole func(ctx context.Context, obj interface{}, next graphql.Resolver, role Role) (res interface{}, err error)
+
+	Suffix func(ctx context.Context, obj interface{}, next graphql.Resolver, text string) (res interface{}, err error)
+
+	Trim func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
+	Admin struct {
+		Name func(childComplexity int) int
+	}
+
 	Circle struct {
 		Radius func(childComplexity int) int
 		Area   func(childComplexity int) int
@@ -102,6 +118,15 @@ type ComplexityRoot struct {
 		Valid             func(childComplexity int) int
 		User              func(childComplexity int, id int) int
 		NullableArg       func(childComplexity int, arg *int) int
+		Patch             func(childComplexity int, input PatchInput, note *string) int
+		Priority          func(childComplexity int, in Priority) int
+		RequiredPriority  func(childComplexity int, in Priority) int
+		Greeting          func(childComplexity int) int
+		Signup            func(childComplexity int, input SignupInput, referrer *string) int
+		Trim              func(childComplexity int, text string, input []TrimInput) int
+		Admin             func(childComplexity int) int
+		SlowResolver      func(childComplexity int) int
+		BlockingResolver  func(childComplexity int) int
 		KeywordArgs       func(childComplexity int, breakArg string, defaultArg string, funcArg string, interfaceArg string, selectArg string, caseArg string, deferArg string, goArg string, mapArg string, structArg string, chanArg string, elseArg string, gotoArg string, packageArg string, switchArg string, constArg string, fallthroughArg string, ifArg string, rangeArg string, typeArg string, continueArg string, forArg string, importArg string, returnArg string, varArg string) int
 	}
 
@@ -142,6 +167,15 @@ type QueryResolver interface {
 	Valid(ctx context.Context) (string, error)
 	User(ctx context.Context, id int) (User, error)
 	NullableArg(ctx context.Context, arg *int) (*string, error)
+	Patch(ctx context.Context, input PatchInput, note *string) (string, error)
+	Priority(ctx context.Context, in Priority) (*Priority, error)
+	RequiredPriority(ctx context.Context, in Priority) (Priority, error)
+	Greeting(ctx context.Context) (string, error)
+	Signup(ctx context.Context, input SignupInput, referrer *string) (bool, error)
+	Trim(ctx context.Context, text string, input []TrimInput) (string, error)
+	Admin(ctx context.Context) (*Admin, error)
+	SlowResolver(ctx context.Context) (*string, error)
+	BlockingResolver(ctx context.Context) (*string, error)
 	KeywordArgs(ctx context.Context, breakArg string, defaultArg string, funcArg string, interfaceArg string, selectArg string, caseArg string, deferArg string, goArg string, mapArg string, structArg string, chanArg string, elseArg string, gotoArg string, packageArg string, switchArg string, constArg string, fallthroughArg string, ifArg string, rangeArg string, typeArg string, continueArg string, forArg string, importArg string, returnArg string, varArg string) (bool, error)
 }
 type SubscriptionResolver interface {
@@ -288,6 +322,160 @@ func field_Query_nullableArg_args(rawArgs map[string]interface{}) (map[string]in
 
 }
 
+func field_Query_patch_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 PatchInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalPatchInput(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["note"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["note"] = arg1
+	return args, nil
+
+}
+
+func field_Query_priority_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 Priority
+	if tmp, ok := rawArgs["in"]; ok {
+		var err error
+		arg0, err = UnmarshalPriority(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["in"] = arg0
+	return args, nil
+
+}
+
+func field_Query_requiredPriority_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 Priority
+	if tmp, ok := rawArgs["in"]; ok {
+		var err error
+		arg0, err = UnmarshalPriority(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["in"] = arg0
+	return args, nil
+
+}
+
+var constraint_Query_signup_referrer = graphql.MustConstraint(map[string]interface{}{"format": "email"})
+
+func field_Query_signup_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 SignupInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		arg0, err = UnmarshalSignupInput(tmp)
+		if err != nil {
+			return nil, graphql.PrefixConstraintPath(err, "input")
+		}
+	}
+	args["input"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["referrer"]; ok {
+		var err error
+		var ptr1 string
+		if tmp != nil {
+			ptr1, err = graphql.UnmarshalString(tmp)
+			arg1 = &ptr1
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		if err := constraint_Query_signup_referrer.Check("referrer", arg1); err != nil {
+			return nil, err
+		}
+	}
+	args["referrer"] = arg1
+	return args, nil
+
+}
+
+func field_Query_trim_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["text"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["text"] = arg0
+	var arg1 []TrimInput
+	if tmp, ok := rawArgs["input"]; ok {
+		var err error
+		var rawIf1 []interface{}
+		if tmp != nil {
+			if tmp1, ok := tmp.([]interface{}); ok {
+				rawIf1 = tmp1
+			} else {
+				rawIf1 = []interface{}{tmp}
+			}
+		}
+		arg1 = make([]TrimInput, len(rawIf1))
+		for idx1 := range rawIf1 {
+			arg1[idx1], err = UnmarshalTrimInput(rawIf1[idx1])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	return args, nil
+
+}
+
+func (ec *executionContext) field_Query_trim_args_directives(ctx context.Context, args map[string]interface{}) error {
+	{
+		tmp, err := ec.directiveValue(ctx, args, parsedSchema.Types["Query"].Fields.ForName("trim").Arguments.ForName("text").Directives, ast.LocationArgumentDefinition, args["text"])
+		if err != nil {
+			return err
+		}
+		switch data := tmp.(type) {
+		case string:
+			args["text"] = data
+		case nil:
+			return fmt.Errorf("a directive on Query.trim(text) returned null")
+		default:
+			return fmt.Errorf("a directive on Query.trim(text) returned %T instead of string", tmp)
+		}
+	}
+	if value, ok := args["input"].([]TrimInput); ok {
+		for idx1 := range value {
+			if err := ec.inputDirectivesTrimInput(ctx, &value[idx1]); err != nil {
+				return err
+			}
+		}
+		args["input"] = value
+	}
+	return nil
+}
+
 func field_Query_keywordArgs_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	args := map[string]interface{}{}
 	var arg0 string
@@ -564,10 +752,41 @@ func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]in
 
 }
 
+func dir_hasRole_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 Role
+	if tmp, ok := rawArgs["role"]; ok {
+		var err error
+		err = (&arg0).UnmarshalGQL(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["role"] = arg0
+	return args, nil
+
+}
+
+func dir_suffix_args(rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["text"]; ok {
+		var err error
+		arg0, err = graphql.UnmarshalString(tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["text"] = arg0
+	return args, nil
+
+}
+
 type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
+	resolvers        ResolverRoot
+	directives       DirectiveRoot
+	complexity       ComplexityRoot
+	concurrencyLimit int
 }
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -577,6 +796,13 @@ func (e *executableSchema) Schema() *ast.Schema {
 func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
 	switch typeName + "." + field {
 
+	case "Admin.name":
+		if e.complexity.Admin.Name == nil {
+			break
+		}
+
+		return e.complexity.Admin.Name(childComplexity), true
+
 	case "Circle.radius":
 		if e.complexity.Circle.Radius == nil {
 			break
@@ -810,6 +1036,94 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.NullableArg(childComplexity, args["arg"].(*int)), true
 
+	case "Query.patch":
+		if e.complexity.Query.Patch == nil {
+			break
+		}
+
+		args, err := field_Query_patch_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Patch(childComplexity, args["input"].(PatchInput), args["note"].(*string)), true
+
+	case "Query.priority":
+		if e.complexity.Query.Priority == nil {
+			break
+		}
+
+		args, err := field_Query_priority_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Priority(childComplexity, args["in"].(Priority)), true
+
+	case "Query.requiredPriority":
+		if e.complexity.Query.RequiredPriority == nil {
+			break
+		}
+
+		args, err := field_Query_requiredPriority_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RequiredPriority(childComplexity, args["in"].(Priority)), true
+
+	case "Query.greeting":
+		if e.complexity.Query.Greeting == nil {
+			break
+		}
+
+		return e.complexity.Query.Greeting(childComplexity), true
+
+	case "Query.signup":
+		if e.complexity.Query.Signup == nil {
+			break
+		}
+
+		args, err := field_Query_signup_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Signup(childComplexity, args["input"].(SignupInput), args["referrer"].(*string)), true
+
+	case "Query.trim":
+		if e.complexity.Query.Trim == nil {
+			break
+		}
+
+		args, err := field_Query_trim_args(rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Trim(childComplexity, args["text"].(string), args["input"].([]TrimInput)), true
+
+	case "Query.admin":
+		if e.complexity.Query.Admin == nil {
+			break
+		}
+
+		return e.complexity.Query.Admin(childComplexity), true
+
+	case "Query.slowResolver":
+		if e.complexity.Query.SlowResolver == nil {
+			break
+		}
+
+		return e.complexity.Query.SlowResolver(childComplexity), true
+
+	case "Query.blockingResolver":
+		if e.complexity.Query.BlockingResolver == nil {
+			break
+		}
+
+		return e.complexity.Query.BlockingResolver(childComplexity), true
+
 	case "Query.keywordArgs":
 		if e.complexity.Query.KeywordArgs == nil {
 			break
@@ -876,7 +1190,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Query(ctx, op.SelectionSet)
@@ -896,7 +1210,7 @@ func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefini
 }
 
 func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	next := ec._Subscription(ctx, op.SelectionSet)
 	if ec.Errors != nil {
@@ -933,6 +1247,71 @@ type executionContext struct {
 	*executableSchema
 }
 
+func (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	if ec.ConcurrencyLimit == 0 {
+		ec.ConcurrencyLimit = e.concurrencyLimit
+	}
+	return ec
+}
+
+var adminImplementors = []string{"Admin"}
+
+// nolint: gocyclo, errcheck, gas, goconst
+func (ec *executionContext) _Admin(ctx context.Context, sel ast.SelectionSet, obj *Admin) graphql.Marshaler {
+	fields := graphql.CollectFields(ctx, sel, adminImplementors)
+
+	out := graphql.NewOrderedMap(len(fields))
+	invalid := false
+	for i, field := range fields {
+		out.Keys[i] = field.Alias
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Admin")
+		case "name":
+			out.Values[i] = ec._Admin_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalid = true
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+
+	if invalid {
+		return graphql.Null
+	}
+	return out
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Admin_name(ctx context.Context, field graphql.CollectedField, obj *Admin) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Admin",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
 var circleImplementors = []string{"Circle", "Shape"}
 
 // nolint: gocyclo, errcheck, gas, goconst
@@ -1257,11 +1636,12 @@ func (ec *executionContext) _ForcedResolver(ctx context.Context, sel ast.Selecti
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("ForcedResolver")
 		case "field":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._ForcedResolver_field(ctx, field, obj)
 				wg.Done()
-			}(i, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1286,7 +1666,9 @@ func (ec *executionContext) _ForcedResolver_field(ctx context.Context, field gra
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ForcedResolver().Field(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.ForcedResolver().Field(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1489,28 +1871,30 @@ func (ec *executionContext) _ModelMethods(ctx context.Context, sel ast.Selection
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("ModelMethods")
 		case "resolverField":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._ModelMethods_resolverField(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "noContext":
 			out.Values[i] = ec._ModelMethods_noContext(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalid = true
 			}
 		case "withContext":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._ModelMethods_withContext(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1535,7 +1919,9 @@ func (ec *executionContext) _ModelMethods_resolverField(ctx context.Context, fie
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ModelMethods().ResolverField(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.ModelMethods().ResolverField(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1589,7 +1975,9 @@ func (ec *executionContext) _ModelMethods_withContext(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.WithContext(ctx), nil
+		return graphql.ResolveWithTimeout(rctx, func(ctx context.Context) (interface{}, error) {
+			return obj.WithContext(ctx), nil
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1681,109 +2069,201 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
 		case "invalidIdentifier":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_invalidIdentifier(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "collision":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_collision(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "mapInput":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_mapInput(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "recursive":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_recursive(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "nestedInputs":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_nestedInputs(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "nestedOutputs":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_nestedOutputs(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "keywords":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_keywords(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "shapes":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_shapes(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "errorBubble":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_errorBubble(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "modelMethods":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_modelMethods(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "valid":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_valid(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "user":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_user(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "nullableArg":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_nullableArg(ctx, field)
 				wg.Done()
-			}(i, field)
-		case "keywordArgs":
+			})
+		case "patch":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
-				out.Values[i] = ec._Query_keywordArgs(ctx, field)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_patch(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
+			})
+		case "priority":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_priority(ctx, field)
+				wg.Done()
+			})
+		case "requiredPriority":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_requiredPriority(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			})
+		case "greeting":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_greeting(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			})
+		case "signup":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_signup(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			})
+		case "trim":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_trim(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			})
+		case "admin":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_admin(ctx, field)
+				wg.Done()
+			})
+		case "slowResolver":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_slowResolver(ctx, field)
+				wg.Done()
+			})
+		case "blockingResolver":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_blockingResolver(ctx, field)
+				wg.Done()
+			})
+		case "keywordArgs":
+			i, field := i, field
+			wg.Add(1)
+			ec.Go(func() {
+				out.Values[i] = ec._Query_keywordArgs(ctx, field)
+				if out.Values[i] == graphql.Null {
+					invalid = true
+				}
+				wg.Done()
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
 	wg.Wait()
 	if invalid {
 		return graphql.Null
@@ -1804,7 +2284,9 @@ func (ec *executionContext) _Query_invalidIdentifier(ctx context.Context, field
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().InvalidIdentifier(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().InvalidIdentifier(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1833,7 +2315,9 @@ func (ec *executionContext) _Query_collision(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Collision(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Collision(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1853,7 +2337,7 @@ func (ec *executionContext) _Query_collision(ctx context.Context, field graphql.
 func (ec *executionContext) _Query_mapInput(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_mapInput_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1868,7 +2352,9 @@ func (ec *executionContext) _Query_mapInput(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MapInput(rctx, args["input"].(*map[string]interface{}))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().MapInput(rctx, args["input"].(*map[string]interface{}))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1887,7 +2373,7 @@ func (ec *executionContext) _Query_mapInput(ctx context.Context, field graphql.C
 func (ec *executionContext) _Query_recursive(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_recursive_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1902,7 +2388,9 @@ func (ec *executionContext) _Query_recursive(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Recursive(rctx, args["input"].(*RecursiveInputSlice))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Recursive(rctx, args["input"].(*RecursiveInputSlice))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1921,7 +2409,7 @@ func (ec *executionContext) _Query_recursive(ctx context.Context, field graphql.
 func (ec *executionContext) _Query_nestedInputs(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_nestedInputs_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1936,7 +2424,9 @@ func (ec *executionContext) _Query_nestedInputs(ctx context.Context, field graph
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().NestedInputs(rctx, args["input"].([][]*OuterInput))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().NestedInputs(rctx, args["input"].([][]*OuterInput))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1964,7 +2454,9 @@ func (ec *executionContext) _Query_nestedOutputs(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().NestedOutputs(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().NestedOutputs(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -2024,7 +2516,7 @@ func (ec *executionContext) _Query_nestedOutputs(ctx context.Context, field grap
 					if isLen1 {
 						f(idx2)
 					} else {
-						go f(idx2)
+						ec.Go(func() { f(idx2) })
 					}
 
 				}
@@ -2035,7 +2527,7 @@ func (ec *executionContext) _Query_nestedOutputs(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2047,7 +2539,7 @@ func (ec *executionContext) _Query_nestedOutputs(ctx context.Context, field grap
 func (ec *executionContext) _Query_keywords(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_keywords_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2062,7 +2554,9 @@ func (ec *executionContext) _Query_keywords(ctx context.Context, field graphql.C
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Keywords(rctx, args["input"].(*Keywords))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Keywords(rctx, args["input"].(*Keywords))
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2089,7 +2583,9 @@ func (ec *executionContext) _Query_shapes(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Shapes(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Shapes(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -2129,7 +2625,7 @@ func (ec *executionContext) _Query_shapes(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2150,7 +2646,9 @@ func (ec *executionContext) _Query_errorBubble(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ErrorBubble(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().ErrorBubble(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -2172,31 +2670,353 @@ func (ec *executionContext) _Query_modelMethods(ctx context.Context, field graph
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
 		Object: "Query",
-		Args:   nil,
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().ModelMethods(rctx)
+		})
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*ModelMethods)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._ModelMethods(ctx, field.Selections, res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_valid(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Valid(rctx)
+		})
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_user_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().User(rctx, args["id"].(int))
+		})
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(User)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	return ec._User(ctx, field.Selections, &res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_nullableArg(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_nullableArg_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().NullableArg(rctx, args["arg"].(*int))
+		})
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_patch(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_patch_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Patch(rctx, args["input"].(PatchInput), args["note"].(*string))
+		})
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_priority(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_priority_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Priority(rctx, args["in"].(Priority))
+		})
+	})
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Priority)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	if m := MarshalPriority(*res); m != graphql.Null {
+		return m
+	}
+	ec.Errorf(ctx, "%v is not a valid Priority", *res)
+	return graphql.Null
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_requiredPriority(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_requiredPriority_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().RequiredPriority(rctx, args["in"].(Priority))
+		})
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(Priority)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+
+	if m := MarshalPriority(res); m != graphql.Null {
+		return m
+	}
+	ec.Errorf(ctx, "%v is not a valid Priority", res)
+	return graphql.Null
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_greeting(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   nil,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Greeting(rctx)
+		})
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalString(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_signup(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_signup_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
+		Field:  field,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Signup(rctx, args["input"].(SignupInput), args["referrer"].(*string))
+		})
+	})
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return graphql.MarshalBoolean(res)
+}
+
+// nolint: vetshadow
+func (ec *executionContext) _Query_trim(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
+	args, err := field_Query_trim_args(rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx := &graphql.ResolverContext{
+		Object: "Query",
+		Args:   args,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
+	if err := ec.field_Query_trim_args_directives(ctx, args); err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ModelMethods(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Trim(rctx, args["text"].(string), args["input"].([]TrimInput))
+		})
 	})
 	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*ModelMethods)
+	res := resTmp.(string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-
-	if res == nil {
-		return graphql.Null
-	}
-
-	return ec._ModelMethods(ctx, field.Selections, res)
+	return graphql.MarshalString(res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query_valid(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Query_admin(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
 	rctx := &graphql.ResolverContext{
@@ -2208,74 +3028,70 @@ func (ec *executionContext) _Query_valid(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Valid(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Admin(rctx)
+		})
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*Admin)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
-	return graphql.MarshalString(res)
+
+	if res == nil {
+		return graphql.Null
+	}
+
+	return ec._Admin(ctx, field.Selections, res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Query_slowResolver(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_user_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
 		Object: "Query",
-		Args:   args,
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().User(rctx, args["id"].(int))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().SlowResolver(rctx)
+		})
 	})
 	if resTmp == nil {
-		if !ec.HasError(rctx) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(User)
+	res := resTmp.(*string)
 	rctx.Result = res
 	ctx = ec.Tracer.StartFieldChildExecution(ctx)
 
-	return ec._User(ctx, field.Selections, &res)
+	if res == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalString(*res)
 }
 
 // nolint: vetshadow
-func (ec *executionContext) _Query_nullableArg(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
+func (ec *executionContext) _Query_blockingResolver(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := field_Query_nullableArg_args(rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
 	rctx := &graphql.ResolverContext{
 		Object: "Query",
-		Args:   args,
+		Args:   nil,
 		Field:  field,
 	}
 	ctx = graphql.WithResolverContext(ctx, rctx)
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().NullableArg(rctx, args["arg"].(*int))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().BlockingResolver(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -2294,7 +3110,7 @@ func (ec *executionContext) _Query_nullableArg(ctx context.Context, field graphq
 func (ec *executionContext) _Query_keywordArgs(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_keywordArgs_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2309,7 +3125,9 @@ func (ec *executionContext) _Query_keywordArgs(ctx context.Context, field graphq
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().KeywordArgs(rctx, args["break"].(string), args["default"].(string), args["func"].(string), args["interface"].(string), args["select"].(string), args["case"].(string), args["defer"].(string), args["go"].(string), args["map"].(string), args["struct"].(string), args["chan"].(string), args["else"].(string), args["goto"].(string), args["package"].(string), args["switch"].(string), args["const"].(string), args["fallthrough"].(string), args["if"].(string), args["range"].(string), args["type"].(string), args["continue"].(string), args["for"].(string), args["import"].(string), args["return"].(string), args["var"].(string))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().KeywordArgs(rctx, args["break"].(string), args["default"].(string), args["func"].(string), args["interface"].(string), args["select"].(string), args["case"].(string), args["defer"].(string), args["go"].(string), args["map"].(string), args["struct"].(string), args["chan"].(string), args["else"].(string), args["goto"].(string), args["package"].(string), args["switch"].(string), args["const"].(string), args["fallthrough"].(string), args["if"].(string), args["range"].(string), args["type"].(string), args["continue"].(string), args["for"].(string), args["import"].(string), args["return"].(string), args["var"].(string))
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2327,7 +3145,7 @@ func (ec *executionContext) _Query_keywordArgs(ctx context.Context, field graphq
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query___type_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2580,14 +3398,15 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 				invalid = true
 			}
 		case "friends":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._User_friends(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2639,7 +3458,9 @@ func (ec *executionContext) _User_friends(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Friends(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.User().Friends(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -2678,7 +3499,7 @@ func (ec *executionContext) _User_friends(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2867,7 +3688,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3172,7 +3993,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3519,7 +4340,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3672,7 +4493,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3809,7 +4630,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_fields_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3860,7 +4681,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3917,7 +4738,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3974,7 +4795,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -3986,7 +4807,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_enumValues_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4037,7 +4858,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -4094,7 +4915,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -4157,6 +4978,29 @@ func (ec *executionContext) _ShapeUnion(ctx context.Context, sel ast.SelectionSe
 	}
 }
 
+var constraint_AddressInput_zip = graphql.MustConstraint(map[string]interface{}{"pattern": "^[0-9]{5}$"})
+
+func UnmarshalAddressInput(v interface{}) (AddressInput, error) {
+	var it AddressInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "zip":
+			var err error
+			it.Zip, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+			if err := constraint_AddressInput_zip.Check(k, it.Zip); err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func UnmarshalInnerInput(v interface{}) (InnerInput, error) {
 	var it InnerInput
 	var asMap = v.(map[string]interface{})
@@ -4355,6 +5199,61 @@ func UnmarshalOuterInput(v interface{}) (OuterInput, error) {
 	return it, nil
 }
 
+func UnmarshalPatchInput(v interface{}) (PatchInput, error) {
+	var it PatchInput
+	var asMap = v.(map[string]interface{})
+	it.IsSet = make(map[string]bool, len(asMap))
+	for k := range asMap {
+		it.IsSet[k] = true
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Name = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "age":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.Age = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		case "tags":
+			var err error
+			var rawIf1 []interface{}
+			if v != nil {
+				if tmp1, ok := v.([]interface{}); ok {
+					rawIf1 = tmp1
+				} else {
+					rawIf1 = []interface{}{v}
+				}
+			}
+			it.Tags = make([]string, len(rawIf1))
+			for idx1 := range rawIf1 {
+				it.Tags[idx1], err = graphql.UnmarshalString(rawIf1[idx1])
+			}
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func UnmarshalRecursiveInputSlice(v interface{}) (RecursiveInputSlice, error) {
 	var it RecursiveInputSlice
 	var asMap = v.(map[string]interface{})
@@ -4384,6 +5283,150 @@ func UnmarshalRecursiveInputSlice(v interface{}) (RecursiveInputSlice, error) {
 	return it, nil
 }
 
+var constraint_SignupInput_name = graphql.MustConstraint(map[string]interface{}{"maxLength": 10, "minLength": 2})
+var constraint_SignupInput_code = graphql.MustConstraint(map[string]interface{}{"pattern": "^[A-Z]{3}$"})
+var constraint_SignupInput_age = graphql.MustConstraint(map[string]interface{}{"min": 18})
+
+func UnmarshalSignupInput(v interface{}) (SignupInput, error) {
+	var it SignupInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+			if err := constraint_SignupInput_name.Check(k, it.Name); err != nil {
+				return it, err
+			}
+		case "code":
+			var err error
+			var ptr1 string
+			if v != nil {
+				ptr1, err = graphql.UnmarshalString(v)
+				it.Code = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+			if err := constraint_SignupInput_code.Check(k, it.Code); err != nil {
+				return it, err
+			}
+		case "age":
+			var err error
+			var ptr1 int
+			if v != nil {
+				ptr1, err = graphql.UnmarshalInt(v)
+				it.Age = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+			if err := constraint_SignupInput_age.Check(k, it.Age); err != nil {
+				return it, err
+			}
+		case "address":
+			var err error
+			var ptr1 AddressInput
+			if v != nil {
+				ptr1, err = UnmarshalAddressInput(v)
+				it.Address = &ptr1
+			}
+
+			if err != nil {
+				return it, graphql.PrefixConstraintPath(err, k)
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func UnmarshalTrimInput(v interface{}) (TrimInput, error) {
+	var it TrimInput
+	var asMap = v.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = graphql.UnmarshalString(v)
+			if err != nil {
+				return it, err
+			}
+		case "nested":
+			var err error
+			var ptr1 TrimInput
+			if v != nil {
+				ptr1, err = UnmarshalTrimInput(v)
+				it.Nested = &ptr1
+			}
+
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) inputDirectivesTrimInput(ctx context.Context, it *TrimInput) error {
+	{
+		tmp, err := ec.directiveValue(ctx, it, parsedSchema.Types["TrimInput"].Fields.ForName("name").Directives, ast.LocationInputFieldDefinition, it.Name)
+		if err != nil {
+			return err
+		}
+		switch data := tmp.(type) {
+		case string:
+			it.Name = data
+		case nil:
+			return fmt.Errorf("a directive on TrimInput.name returned null")
+		default:
+			return fmt.Errorf("a directive on TrimInput.name returned %T instead of string", tmp)
+		}
+	}
+	if it.Nested != nil {
+		if err := ec.inputDirectivesTrimInput(ctx, it.Nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func UnmarshalPriority(v interface{}) (Priority, error) {
+	var it Priority
+	str, ok := v.(string)
+	if !ok {
+		return it, fmt.Errorf("enums must be strings")
+	}
+
+	switch str {
+	case "LOW":
+		return PriorityLow, nil
+	case "HIGH":
+		return PriorityHigh, nil
+	}
+	return it, fmt.Errorf("%s is not a valid Priority, expected one of LOW, HIGH", str)
+}
+
+// MarshalPriority returns null for go values that aren't mapped to a graphql value, a field that resolves to one
+// of them gets an error
+func MarshalPriority(v Priority) graphql.Marshaler {
+	switch v {
+	case PriorityLow:
+		return graphql.MarshalString("LOW")
+	case PriorityHigh:
+		return graphql.MarshalString("HIGH")
+	}
+	return graphql.Null
+}
+
 func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) (ret interface{}) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -4391,6 +5434,54 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 			ret = nil
 		}
 	}()
+	rctx := graphql.GetResolverContext(ctx)
+	timeout := ec.ResolverTimeout
+	for _, d := range rctx.Field.Definition.Directives {
+		if d.Name == "timeout" {
+			ms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)["ms"])
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
+			}
+			timeout = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives on enum values used in the arguments and on the object run outside of those on the field
+	for _, d := range graphql.EnumValueDirectives(parsedSchema, rctx.Field, ec.Variables) {
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationEnumValue, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	for _, d := range parsedSchema.Types[rctx.Object].Directives {
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationObject, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives in the query run outside of those in the schema
+	for _, d := range rctx.Field.QueryDirectives() {
+		var err error
+		next, err = ec.directive(obj, d, d.Location, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	if timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {
+		ctx = graphql.WithFieldTimeout(ctx, timeout)
+	}
 	res, err := ec.ResolverMiddleware(ctx, next)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4399,6 +5490,70 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 	return res
 }
 
+// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one
+func (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {
+	switch d.Name {
+	case "audit":
+		if ec.directives.Audit != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.Audit(ctx, obj, n)
+			}, nil
+		}
+	case "hasRole":
+		if ec.directives.HasRole != nil {
+			rawArgs := d.ArgumentMap(ec.Variables)
+			args, err := dir_hasRole_args(rawArgs)
+			if err != nil {
+				return nil, err
+			}
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.HasRole(ctx, obj, n, args["role"].(Role))
+			}, nil
+		}
+	case "suffix":
+		if ec.directives.Suffix != nil {
+			rawArgs := d.ArgumentMap(ec.Variables)
+			args, err := dir_suffix_args(rawArgs)
+			if err != nil {
+				return nil, err
+			}
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.Suffix(ctx, obj, n, args["text"].(string))
+			}, nil
+		}
+	case "trim":
+		if ec.directives.Trim != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.Trim(ctx, obj, n)
+			}, nil
+		}
+	}
+	return next, nil
+}
+
+// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value
+func (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {
+	next := func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+	for _, d := range directives {
+		var err error
+		next, err = ec.directive(obj, d, location, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
+
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
@@ -4414,7 +5569,13 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var parsedSchema = gqlparser.MustLoadSchema(
-	&ast.Source{Name: "schema.graphql", Input: `type Query {
+	&ast.Source{Name: "schema.graphql", Input: `directive @timeout(ms: Int!) on FIELD_DEFINITION
+directive @suffix(text: String! = "!") on FIELD_DEFINITION | FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+directive @trim on ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+directive @hasRole(role: Role!) on OBJECT
+directive @audit on ENUM_VALUE
+
+type Query {
     invalidIdentifier: InvalidIdentifier
     collision: It
     mapInput(input: Changes): Boolean
@@ -4428,6 +5589,15 @@ var parsedSchema = gqlparser.MustLoadSchema(
     valid: String!
     user(id: Int!): User!
     nullableArg(arg: Int = 123): String
+    patch(input: PatchInput!, note: String): String!
+    priority(in: Priority!): Priority
+    requiredPriority(in: Priority!): Priority!
+    greeting: String! @suffix(text: ", world")
+    signup(input: SignupInput!, referrer: String): Boolean!
+    trim(text: String! @trim, input: [TrimInput!]): String!
+    admin: Admin
+    slowResolver: String @timeout(ms: 10)
+    blockingResolver: String
 }
 
 type Subscription {
@@ -4461,6 +5631,42 @@ type It {
     id: ID!
 }
 
+enum Priority {
+    LOW
+    HIGH @audit
+}
+
+enum Role {
+    ADMIN
+    USER
+}
+
+type Admin @hasRole(role: ADMIN) {
+    name: String!
+}
+
+input PatchInput {
+    name: String
+    age: Int
+    tags: [String!] = []
+}
+
+input SignupInput {
+    name: String!
+    code: String
+    age: Int
+    address: AddressInput
+}
+
+input TrimInput {
+    name: String! @trim
+    nested: TrimInput
+}
+
+input AddressInput {
+    zip: String!
+}
+
 input Changes {
     a: Int
     b: Int
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -188,6 +189,71 @@ func TestGeneratedServer(t *testing.T) {
 		require.Nil(t, err)
 		require.Equal(t, "Ok", *resp.NullableArg)
 	})
+
+	t.Run("explicit null and omitted fields", func(t *testing.T) {
+		var resp struct {
+			Patch string
+		}
+		err := c.Post(`query { patch(input: {name: null}) }`, &resp)
+		require.NoError(t, err)
+		require.Equal(t, "name=<nil> age=- tags=- note=-", resp.Patch)
+
+		err = c.Post(`query($age: Int, $note: String) { patch(input: {name: "bob", age: $age}, note: $note) }`, &resp,
+			client.Var("age", 32), client.Var("note", nil))
+		require.NoError(t, err)
+		require.Equal(t, "name=bob age=32 tags=- note=<nil>", resp.Patch)
+
+		err = c.Post(`query($age: Int) { patch(input: {name: "bob", age: $age}) }`, &resp)
+		require.NoError(t, err)
+		require.Equal(t, "name=bob age=- tags=- note=-", resp.Patch)
+	})
+
+	t.Run("int enums", func(t *testing.T) {
+		var resp struct {
+			Priority *string
+		}
+		err := c.Post(`query { priority(in: LOW) }`, &resp)
+		require.NoError(t, err)
+		require.Equal(t, "LOW", *resp.Priority)
+
+		err = c.Post(`query { priority(in: HIGH) }`, &resp)
+		require.EqualError(t, err, `[{"message":"2 is not a valid Priority","path":["priority"]}]`)
+		require.Nil(t, resp.Priority, "go values without a graphql value are null")
+
+		var required struct {
+			RequiredPriority string
+		}
+		err = c.Post(`query { requiredPriority(in: LOW) }`, &required)
+		require.NoError(t, err)
+		require.Equal(t, "LOW", required.RequiredPriority)
+
+		err = c.Post(`query { requiredPriority(in: HIGH) }`, &required)
+		require.EqualError(t, err, `[{"message":"2 is not a valid Priority","path":["requiredPriority"]}]`)
+
+		_, err = UnmarshalPriority("MEDIUM")
+		require.EqualError(t, err, "MEDIUM is not a valid Priority, expected one of LOW, HIGH")
+	})
+
+	t.Run("constraints", func(t *testing.T) {
+		var resp struct {
+			Signup bool
+		}
+		err := c.Post(`query { signup(input: {name: "bob", code: "ABC", age: 18, address: {zip: "12345"}}, referrer: "amy@example.com") }`, &resp)
+		require.NoError(t, err)
+		require.True(t, resp.Signup)
+
+		err = c.Post(`query { signup(input: {name: "b"}) }`, &resp)
+		require.EqualError(t, err, `[{"message":"input.name must be at least 2 characters","extensions":{"code":"VALIDATION_FAILED","constraint":"minLength","input":["input","name"]}}]`)
+
+		err = c.Post(`query { signup(input: {name: "bob", age: 17}) }`, &resp)
+		require.EqualError(t, err, `[{"message":"input.age must be at least 18","extensions":{"code":"VALIDATION_FAILED","constraint":"min","input":["input","age"]}}]`)
+
+		err = c.Post(`query($zip: String!) { signup(input: {name: "bob", address: {zip: $zip}}) }`, &resp, client.Var("zip", "123"))
+		require.EqualError(t, err, `[{"message":"input.address.zip must match ^[0-9]{5}$","extensions":{"code":"VALIDATION_FAILED","constraint":"pattern","input":["input","address","zip"]}}]`)
+
+		err = c.Post(`query { signup(input: {name: "bob"}, referrer: "amy") }`, &resp)
+		require.EqualError(t, err, `[{"message":"referrer must be a valid email address","extensions":{"code":"VALIDATION_FAILED","constraint":"format","input":["referrer"]}}]`)
+	})
 }
 
 func TestIntrospection(t *testing.T) {
@@ -657,6 +723,291 @@ func TestResponseExtension(t *testing.T) {
 	require.Equal(t, raw.Extensions["example"], "value")
 }
 
+func TestResolverTimeout(t *testing.T) {
+	t.Run("from directive", func(t *testing.T) {
+		srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(Config{Resolvers: &testResolver{}})))
+		defer srv.Close()
+		c := client.New(srv.URL)
+
+		var resp struct {
+			Valid        string
+			SlowResolver *string
+		}
+		err := c.Post(`query { valid, slowResolver }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"resolver timed out after 10ms","path":["slowResolver"],"extensions":{"code":"TIMEOUT"}}]`)
+		require.Nil(t, resp.SlowResolver)
+		require.Equal(t, "Ok", resp.Valid)
+	})
+
+	t.Run("from handler default", func(t *testing.T) {
+		srv := httptest.NewServer(handler.GraphQL(
+			NewExecutableSchema(Config{Resolvers: &testResolver{}}),
+			handler.ResolverTimeout(20*time.Millisecond),
+		))
+		defer srv.Close()
+		c := client.New(srv.URL)
+
+		var resp struct {
+			Valid            string
+			SlowResolver     *string
+			BlockingResolver *string
+		}
+		err := c.Post(`query { valid, slowResolver, blockingResolver }`, &resp)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `{"message":"resolver timed out after 10ms","path":["slowResolver"],"extensions":{"code":"TIMEOUT"}}`)
+		require.Contains(t, err.Error(), `{"message":"resolver timed out after 20ms","path":["blockingResolver"],"extensions":{"code":"TIMEOUT"}}`)
+		require.Nil(t, resp.SlowResolver)
+		require.Nil(t, resp.BlockingResolver)
+		require.Equal(t, "Ok", resp.Valid)
+	})
+}
+
+func TestDirectives(t *testing.T) {
+	var mu sync.Mutex
+	var locations []string
+	cfg := Config{Resolvers: &testResolver{}}
+	cfg.Directives.Suffix = func(ctx context.Context, obj interface{}, next graphql.Resolver, text string) (interface{}, error) {
+		dc := graphql.GetDirectiveContext(ctx)
+		mu.Lock()
+		locations = append(locations, fmt.Sprintf("%s %v", dc.Location, dc.FromQuery()))
+		mu.Unlock()
+
+		res, err := next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return res.(string) + text, nil
+	}
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(cfg)))
+	defer srv.Close()
+	c := client.New(srv.URL)
+
+	query := func(query string, options ...client.Option) string {
+		locations = nil
+		var resp struct {
+			Valid    string
+			Greeting string
+		}
+		c.MustPost(query, &resp, options...)
+		return resp.Valid + resp.Greeting
+	}
+
+	t.Run("from the schema", func(t *testing.T) {
+		require.Equal(t, "hello, world", query(`query { greeting }`))
+		require.Equal(t, []string{"FIELD_DEFINITION false"}, locations)
+	})
+
+	t.Run("on a field", func(t *testing.T) {
+		require.Equal(t, "Ok?", query(`query { valid @suffix(text: "?") }`))
+		require.Equal(t, []string{"FIELD true"}, locations)
+
+		require.Equal(t, "Ok!", query(`query { valid @suffix }`), "default arguments are used")
+	})
+
+	t.Run("with variables", func(t *testing.T) {
+		require.Equal(t, "Ok.", query(`query($text: String!) { valid @suffix(text: $text) }`, client.Var("text", ".")))
+	})
+
+	t.Run("on fragments", func(t *testing.T) {
+		require.Equal(t, "Ok123", query(`
+			query { ... on Query @suffix(text: "3") { ...F @suffix(text: "2") } }
+			fragment F on Query { valid @suffix(text: "1") }
+		`))
+		require.Equal(t, []string{"INLINE_FRAGMENT true", "FRAGMENT_SPREAD true", "FIELD true"}, locations)
+	})
+
+	t.Run("query directives run outside of schema directives", func(t *testing.T) {
+		require.Equal(t, "hello, world?", query(`query { greeting @suffix(text: "?") }`))
+	})
+}
+
+func TestInputDirectives(t *testing.T) {
+	var mu sync.Mutex
+	var locations []string
+	var viewerRole Role
+	cfg := Config{Resolvers: &testResolver{}}
+	cfg.Directives.Trim = func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+		mu.Lock()
+		locations = append(locations, string(graphql.GetDirectiveContext(ctx).Location))
+		mu.Unlock()
+
+		res, err := next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := res.(string); ok {
+			if s == "" {
+				return nil, fmt.Errorf("must not be blank")
+			}
+			return strings.TrimSpace(s), nil
+		}
+		return res, nil
+	}
+	cfg.Directives.HasRole = func(ctx context.Context, obj interface{}, next graphql.Resolver, role Role) (interface{}, error) {
+		if role != viewerRole {
+			return nil, fmt.Errorf("forbidden")
+		}
+		return next(ctx)
+	}
+	cfg.Directives.Audit = func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+		mu.Lock()
+		locations = append(locations, string(graphql.GetDirectiveContext(ctx).Location))
+		mu.Unlock()
+		return next(ctx)
+	}
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(cfg)))
+	defer srv.Close()
+	c := client.New(srv.URL)
+
+	t.Run("on arguments and input fields", func(t *testing.T) {
+		locations = nil
+		var resp struct{ Trim string }
+		c.MustPost(`query { trim(text: "  a ", input: [{name: " b"}, {name: "c ", nested: {name: " d "}}]) }`, &resp)
+		require.Equal(t, "a|b|c|d", resp.Trim)
+		require.Equal(t, []string{"ARGUMENT_DEFINITION", "INPUT_FIELD_DEFINITION", "INPUT_FIELD_DEFINITION", "INPUT_FIELD_DEFINITION"}, locations)
+	})
+
+	t.Run("with variables", func(t *testing.T) {
+		var resp struct{ Trim string }
+		c.MustPost(`query($input: [TrimInput!]) { trim(text: "a", input: $input) }`, &resp, client.Var("input", []map[string]interface{}{{"name": " b "}}))
+		require.Equal(t, "a|b", resp.Trim)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var resp struct{ Trim *string }
+		err := c.Post(`query { trim(text: "a", input: [{name: ""}]) }`, &resp)
+		require.EqualError(t, err, `[{"message":"must not be blank","path":["trim"]}]`)
+	})
+
+	t.Run("on objects", func(t *testing.T) {
+		var resp struct{ Admin *struct{ Name string } }
+		viewerRole = RoleUser
+		err := c.Post(`query { admin { name } }`, &resp)
+		require.EqualError(t, err, `[{"message":"forbidden","path":["admin","name"]}]`)
+
+		viewerRole = RoleAdmin
+		c.MustPost(`query { admin { name } }`, &resp)
+		require.Equal(t, "root", resp.Admin.Name)
+	})
+
+	t.Run("on enum values", func(t *testing.T) {
+		locations = nil
+		var resp struct{ Priority *string }
+		c.MustPost(`query { priority(in: LOW) }`, &resp)
+		require.Empty(t, locations)
+
+		// HIGH resolves to a go value without a graphql value, which is an error after the directive has run
+		_ = c.Post(`query { priority(in: HIGH) }`, &resp)
+		require.Equal(t, []string{"ENUM_VALUE"}, locations)
+	})
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	resolvers := &testResolver{}
+	resolvers.userFriends = func(ctx context.Context, obj *User) ([]User, error) {
+		return []User{{ID: obj.ID + 1}, {ID: obj.ID + 2}}, nil
+	}
+
+	for _, limit := range []int{1, 2} {
+		t.Run(fmt.Sprintf("handler limit of %d", limit), func(t *testing.T) {
+			srv := httptest.NewServer(handler.GraphQL(
+				NewExecutableSchema(Config{Resolvers: resolvers}),
+				handler.ConcurrencyLimit(limit),
+			))
+			defer srv.Close()
+			c := client.New(srv.URL)
+
+			var resp struct {
+				User struct {
+					ID      int
+					Friends []struct {
+						ID      int
+						Friends []struct {
+							ID int
+						}
+					}
+				}
+			}
+			err := c.Post(`query { user(id: 1) { id, friends { id, friends { id } } } }`, &resp)
+			require.NoError(t, err)
+			require.Len(t, resp.User.Friends, 2)
+			require.Equal(t, 3, resp.User.Friends[0].Friends[0].ID)
+			require.Equal(t, 5, resp.User.Friends[1].Friends[1].ID)
+		})
+	}
+
+	t.Run("default from config", func(t *testing.T) {
+		srv := httptest.NewServer(handler.GraphQL(
+			NewExecutableSchema(Config{Resolvers: resolvers, ConcurrencyLimit: 1}),
+			handler.RequestMiddleware(func(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+				res := next(ctx)
+				assert.Equal(t, 1, graphql.GetRequestContext(ctx).ConcurrencyLimit)
+				return res
+			}),
+		))
+		defer srv.Close()
+		c := client.New(srv.URL)
+
+		var resp struct {
+			User struct {
+				Friends []struct {
+					ID int
+				}
+			}
+		}
+		err := c.Post(`query { user(id: 1) { friends { id } } }`, &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.User.Friends, 2)
+	})
+}
+
+func TestResolverContextAncestors(t *testing.T) {
+	resolvers := &testResolver{}
+	resolvers.userFriends = func(ctx context.Context, obj *User) ([]User, error) {
+		rctx := graphql.GetResolverContext(ctx)
+		switch parent := rctx.ParentResult().(type) {
+		case User:
+			if parent.ID != obj.ID {
+				return nil, fmt.Errorf("parent result does not match obj")
+			}
+		case *User:
+			if parent != obj {
+				return nil, fmt.Errorf("parent result does not match obj")
+			}
+		default:
+			return nil, fmt.Errorf("unexpected parent result %T", parent)
+		}
+
+		id, _ := rctx.Ancestor("user").Arg("id")
+		friends := []User{{ID: id.(int)*10 + obj.ID}}
+		if i, ok := rctx.ListIndex(); ok {
+			friends[0].ID += i * 100
+		}
+		return friends, nil
+	}
+
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(Config{Resolvers: resolvers})))
+	defer srv.Close()
+	c := client.New(srv.URL)
+
+	var resp struct {
+		User struct {
+			Friends []struct {
+				ID      int
+				Friends []struct {
+					ID int
+				}
+			}
+		}
+	}
+	err := c.Post(`query { user(id: 2) { friends { id, friends { id } } } }`, &resp)
+	require.NoError(t, err)
+	require.Equal(t, 21, resp.User.Friends[0].ID)
+	require.Equal(t, 41, resp.User.Friends[0].Friends[0].ID)
+}
+
 type testResolver struct {
 	tick        chan string
 	userFriends func(ctx context.Context, obj *User) ([]User, error)
@@ -702,6 +1053,83 @@ func (r *testQueryResolver) NullableArg(ctx context.Context, arg *int) (*string,
 	return &s, nil
 }
 
+func (r *testQueryResolver) Patch(ctx context.Context, input PatchInput, note *string) (string, error) {
+	describe := func(name string, set bool, val interface{}) string {
+		if !set {
+			return name + "=-"
+		}
+		if v := reflect.ValueOf(val); v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return name + "=<nil>"
+			}
+			val = v.Elem().Interface()
+		}
+		return fmt.Sprintf("%s=%v", name, val)
+	}
+	return strings.Join([]string{
+		describe("name", input.IsSet["name"], input.Name),
+		describe("age", input.IsSet["age"], input.Age),
+		describe("tags", input.IsSet["tags"], input.Tags),
+		describe("note", graphql.IsArgSet(ctx, "note"), note),
+	}, " "), nil
+}
+
+func (r *testQueryResolver) Priority(ctx context.Context, in Priority) (*Priority, error) {
+	if in == PriorityHigh {
+		unmapped := PriorityUnmapped
+		return &unmapped, nil
+	}
+	return &in, nil
+}
+
+func (r *testQueryResolver) RequiredPriority(ctx context.Context, in Priority) (Priority, error) {
+	if in == PriorityHigh {
+		return PriorityUnmapped, nil
+	}
+	return in, nil
+}
+
+func (r *testQueryResolver) Greeting(ctx context.Context) (string, error) {
+	return "hello", nil
+}
+
+func (r *testQueryResolver) Signup(ctx context.Context, input SignupInput, referrer *string) (bool, error) {
+	return true, nil
+}
+
+func (r *testQueryResolver) Trim(ctx context.Context, text string, input []TrimInput) (string, error) {
+	values := []string{text}
+	for _, in := range input {
+		for it := &in; it != nil; it = it.Nested {
+			values = append(values, it.Name)
+		}
+	}
+	return strings.Join(values, "|"), nil
+}
+
+func (r *testQueryResolver) Admin(ctx context.Context) (*Admin, error) {
+	return &Admin{Name: "root"}, nil
+}
+
+func (r *testQueryResolver) SlowResolver(ctx context.Context) (*string, error) {
+	return waitForDeadline(ctx)
+}
+
+func (r *testQueryResolver) BlockingResolver(ctx context.Context) (*string, error) {
+	return waitForDeadline(ctx)
+}
+
+func waitForDeadline(ctx context.Context) (*string, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, fmt.Errorf("expected resolver context to have a deadline")
+	}
+	<-ctx.Done()
+	// give the timeout a head start over the late result
+	time.Sleep(10 * time.Millisecond)
+	s := "too late"
+	return &s, nil
+}
+
 func (r *testQueryResolver) ModelMethods(ctx context.Context) (*ModelMethods, error) {
 	return &ModelMethods{}, nil
 }
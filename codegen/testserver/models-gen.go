@@ -2,6 +2,22 @@
 
 package testserver
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type AddressInput struct {
+	Zip string `json:"zip"`
+}
+
+type Admin struct {
+	Name string `json:"name"`
+}
+
 type InnerInput struct {
 	ID int `json:"id"`
 }
@@ -46,7 +62,85 @@ type OuterObject struct {
 	Inner InnerObject `json:"inner"`
 }
 
+type PatchInput struct {
+	Name *string  `json:"name"`
+	Age  *int     `json:"age"`
+	Tags []string `json:"tags"`
+	// IsSet records which fields were given, including those that were explicitly null
+	IsSet map[string]bool `json:"-"`
+}
+
+type SignupInput struct {
+	Name    string        `json:"name"`
+	Code    *string       `json:"code"`
+	Age     *int          `json:"age"`
+	Address *AddressInput `json:"address"`
+}
+
+type TrimInput struct {
+	Name   string     `json:"name"`
+	Nested *TrimInput `json:"nested"`
+}
+
 type User struct {
 	ID      int    `json:"id"`
 	Friends []User `json:"friends"`
 }
+
+type Role string
+
+const (
+	RoleAdmin Role = "ADMIN"
+	RoleUser  Role = "USER"
+)
+
+var AllRole = []Role{
+	RoleAdmin,
+	RoleUser,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleAdmin, RoleUser:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role, expected one of ADMIN, USER", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("Role must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -44,3 +44,12 @@ type EmbeddedPointerModel struct {
 type EmbeddedPointer struct {
 	Title string
 }
+
+// Priority is bound to the graphql enum using enumValues
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityHigh
+	PriorityUnmapped
+)
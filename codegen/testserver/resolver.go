@@ -78,6 +78,33 @@ func (r *queryResolver) User(ctx context.Context, id int) (User, error) {
 func (r *queryResolver) NullableArg(ctx context.Context, arg *int) (*string, error) {
 	panic("not implemented")
 }
+func (r *queryResolver) Patch(ctx context.Context, input PatchInput, note *string) (string, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) Priority(ctx context.Context, in Priority) (*Priority, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) RequiredPriority(ctx context.Context, in Priority) (Priority, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) Greeting(ctx context.Context) (string, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) Signup(ctx context.Context, input SignupInput, referrer *string) (bool, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) Trim(ctx context.Context, text string, input []TrimInput) (string, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) Admin(ctx context.Context) (*Admin, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) SlowResolver(ctx context.Context) (*string, error) {
+	panic("not implemented")
+}
+func (r *queryResolver) BlockingResolver(ctx context.Context) (*string, error) {
+	panic("not implemented")
+}
 func (r *queryResolver) KeywordArgs(ctx context.Context, breakArg string, defaultArg string, funcArg string, interfaceArg string, selectArg string, caseArg string, deferArg string, goArg string, mapArg string, structArg string, chanArg string, elseArg string, gotoArg string, packageArg string, switchArg string, constArg string, fallthroughArg string, ifArg string, rangeArg string, typeArg string, continueArg string, forArg string, importArg string, returnArg string, varArg string) (bool, error) {
 	panic("not implemented")
 }
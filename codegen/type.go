@@ -18,6 +18,13 @@ type NamedType struct {
 	IsInput     bool
 	GQLType     string // Name of the graphql type
 	Marshaler   *Ref   // If this type has an external marshaler this will be set
+	// IsBoundEnum is set on enums bound to go constants with enumValues, their marshaler returns null for go values
+	// that aren't mapped to a graphql value
+	IsBoundEnum bool
+	// HasConstraints is set on inputs that have a @constraint on any of their fields, including nested inputs
+	HasConstraints bool
+	// HasInputDirectives is set on inputs that have a directive to run on any of their fields, including nested inputs
+	HasInputDirectives bool
 }
 
 type Ref struct {
@@ -89,6 +96,73 @@ func (t NamedType) IsMarshaled() bool {
 	return t.Marshaler != nil
 }
 
+// InputDirectives is the code that runs the field directives of every input held by a value of this type, eg each
+// item of a list. The value must be addressable.
+func (t Type) InputDirectives(value string) string {
+	return t.inputDirectives(value, t.Modifiers, 1)
+}
+
+func (t Type) inputDirectives(value string, remainingMods []string, depth int) string {
+	switch {
+	case len(remainingMods) > 0 && remainingMods[0] == modPtr:
+		next := "if err := ec.inputDirectives" + t.GQLType + "(ctx, " + value + "); err != nil {\n return err\n}"
+		if len(remainingMods) > 1 {
+			next = t.inputDirectives("(*"+value+")", remainingMods[1:], depth)
+		}
+		return tpl(`if {{.value}} != nil {
+				{{.next}}
+			}`, map[string]interface{}{
+			"value": value,
+			"next":  next,
+		})
+
+	case len(remainingMods) > 0 && remainingMods[0] == modList:
+		index := "idx" + strconv.Itoa(depth)
+		return tpl(`for {{.index}} := range {{.value}} {
+				{{.next}}
+			}`, map[string]interface{}{
+			"index": index,
+			"value": value,
+			"next":  t.inputDirectives(value+"["+index+"]", remainingMods[1:], depth+1),
+		})
+
+	default:
+		return "if err := ec.inputDirectives" + t.GQLType + "(ctx, &" + value + "); err != nil {\n return err\n}"
+	}
+}
+
+// DirectiveValue is the code that runs the directives on an argument or input field, replacing the value with
+// whatever the directives return
+func (t Type) DirectiveValue(value, obj, directives, location, name string) string {
+	nilResult := `return fmt.Errorf("a directive on ` + name + ` returned null")`
+	if len(t.Modifiers) > 0 {
+		nilResult = value + " = nil"
+	}
+
+	return tpl(`{
+			tmp, err := ec.directiveValue(ctx, {{.obj}}, {{.directives}}, {{.location}}, {{.value}})
+			if err != nil {
+				return err
+			}
+			switch data := tmp.(type) {
+			case {{.signature}}:
+				{{.value}} = data
+			case nil:
+				{{.nilResult}}
+			default:
+				return fmt.Errorf("a directive on {{.name}} returned %T instead of {{.signature}}", tmp)
+			}
+		}`, map[string]interface{}{
+		"value":      value,
+		"obj":        obj,
+		"directives": directives,
+		"location":   location,
+		"name":       name,
+		"signature":  t.Signature(),
+		"nilResult":  nilResult,
+	})
+}
+
 func (t Type) Unmarshal(result, raw string) string {
 	return t.unmarshal(result, raw, t.Modifiers, 1)
 }
@@ -162,13 +236,18 @@ func (t Type) unmarshal(result, raw string, remainingMods []string, depth int) s
 }
 
 func (t Type) Marshal(val string) string {
+	return "return " + t.marshalValue(val)
+}
+
+// marshalValue is the expression that turns val into a graphql.Marshaler
+func (t Type) marshalValue(val string) string {
 	if t.AliasedType != nil {
 		val = t.GoType + "(" + val + ")"
 	}
 
 	if t.Marshaler != nil {
-		return "return " + t.Marshaler.PkgDot() + "Marshal" + t.Marshaler.GoType + "(" + val + ")"
+		return t.Marshaler.PkgDot() + "Marshal" + t.Marshaler.GoType + "(" + val + ")"
 	}
 
-	return "return " + val
+	return val
 }
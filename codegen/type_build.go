@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/vektah/gqlparser/ast"
-	"golang.org/x/tools/go/loader"
 )
 
 // namedTypeFromSchema objects for every graphql type, including scalars. There should only be one instance of Type for each thing
@@ -17,6 +16,11 @@ func (cfg *Config) buildNamedTypes() NamedTypes {
 		if userEntry, ok := cfg.Models[t.GQLType]; ok && userEntry.Model != "" {
 			t.IsUserDefined = true
 			t.Package, t.GoType = pkgAndType(userEntry.Model)
+			if schemaType.Kind == ast.Enum && len(userEntry.EnumValues) > 0 {
+				// the marshalers for the mapping are generated into the exec package
+				t.Marshaler = &Ref{GoType: t.GQLType, Package: cfg.Exec.ImportPath()}
+				t.IsBoundEnum = true
+			}
 		} else if t.IsScalar {
 			t.Package = "github.com/99designs/gqlgen/graphql"
 			t.GoType = "String"
@@ -27,13 +31,17 @@ func (cfg *Config) buildNamedTypes() NamedTypes {
 	return types
 }
 
-func (cfg *Config) bindTypes(namedTypes NamedTypes, destDir string, prog *loader.Program) {
+func (cfg *Config) bindTypes(namedTypes NamedTypes, destDir string, pkgs *pkgLoader) {
 	for _, t := range namedTypes {
 		if t.Package == "" {
 			continue
 		}
 
-		def, _ := findGoType(prog, t.Package, "Marshal"+t.GoType)
+		if t.Marshaler != nil {
+			continue
+		}
+
+		def, _ := findGoType(pkgs, t.Package, "Marshal"+t.GoType)
 		switch def := def.(type) {
 		case *types.Func:
 			sig := def.Type().(*types.Signature)
@@ -8,41 +8,29 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
-	"golang.org/x/tools/go/loader"
 )
 
-func findGoType(prog *loader.Program, pkgName string, typeName string) (types.Object, error) {
+func findGoType(pkgs *pkgLoader, pkgName string, typeName string) (types.Object, error) {
 	if pkgName == "" {
 		return nil, nil
 	}
-	fullName := typeName
-	if pkgName != "" {
-		fullName = pkgName + "." + typeName
-	}
-
-	pkgName, err := resolvePkg(pkgName)
-	if err != nil {
-		return nil, errors.Errorf("unable to resolve package for %s: %s\n", fullName, err.Error())
-	}
+	fullName := pkgName + "." + typeName
 
-	pkg := prog.Imported[pkgName]
-	if pkg == nil {
+	pkg := pkgs.get(pkgName)
+	if pkg == nil || pkg.Types == nil {
 		return nil, errors.Errorf("required package was not loaded: %s", fullName)
 	}
 
-	for astNode, def := range pkg.Defs {
-		if astNode.Name != typeName || def.Parent() == nil || def.Parent() != pkg.Pkg.Scope() {
-			continue
-		}
-
-		return def, nil
+	def := pkg.Types.Scope().Lookup(typeName)
+	if def == nil {
+		return nil, errors.Errorf("unable to find type %s\n", fullName)
 	}
 
-	return nil, errors.Errorf("unable to find type %s\n", fullName)
+	return def, nil
 }
 
-func findGoNamedType(prog *loader.Program, pkgName string, typeName string) (*types.Named, error) {
-	def, err := findGoType(prog, pkgName, typeName)
+func findGoNamedType(pkgs *pkgLoader, pkgName string, typeName string) (*types.Named, error) {
+	def, err := findGoType(pkgs, pkgName, typeName)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +46,8 @@ func findGoNamedType(prog *loader.Program, pkgName string, typeName string) (*ty
 	return namedType, nil
 }
 
-func findGoInterface(prog *loader.Program, pkgName string, typeName string) (*types.Interface, error) {
-	namedType, err := findGoNamedType(prog, pkgName, typeName)
+func findGoInterface(pkgs *pkgLoader, pkgName string, typeName string) (*types.Interface, error) {
+	namedType, err := findGoNamedType(pkgs, pkgName, typeName)
 	if err != nil {
 		return nil, err
 	}
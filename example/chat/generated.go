@@ -19,9 +19,10 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
-		resolvers:  cfg.Resolvers,
-		directives: cfg.Directives,
-		complexity: cfg.Complexity,
+		resolvers:        cfg.Resolvers,
+		directives:       cfg.Directives,
+		complexity:       cfg.Complexity,
+		concurrencyLimit: cfg.ConcurrencyLimit,
 	}
 }
 
@@ -29,6 +30,9 @@ type Config struct {
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
+	// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,
+	// it is used when the request context doesn't set its own limit.
+	ConcurrencyLimit int
 }
 
 type ResolverRoot interface {
@@ -185,9 +189,10 @@ func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]in
 }
 
 type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
+	resolvers        ResolverRoot
+	directives       DirectiveRoot
+	complexity       ComplexityRoot
+	concurrencyLimit int
 }
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -280,7 +285,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Query(ctx, op.SelectionSet)
@@ -296,7 +301,7 @@ func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinitio
 }
 
 func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Mutation(ctx, op.SelectionSet)
@@ -313,7 +318,7 @@ func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefini
 }
 
 func (e *executableSchema) Subscription(ctx context.Context, op *ast.OperationDefinition) func() *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	next := ec._Subscription(ctx, op.SelectionSet)
 	if ec.Errors != nil {
@@ -350,6 +355,14 @@ type executionContext struct {
 	*executableSchema
 }
 
+func (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	if ec.ConcurrencyLimit == 0 {
+		ec.ConcurrencyLimit = e.concurrencyLimit
+	}
+	return ec
+}
+
 var chatroomImplementors = []string{"Chatroom"}
 
 // nolint: gocyclo, errcheck, gas, goconst
@@ -464,7 +477,7 @@ func (ec *executionContext) _Chatroom_messages(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -663,7 +676,7 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 func (ec *executionContext) _Mutation_post(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Mutation_post_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -678,7 +691,9 @@ func (ec *executionContext) _Mutation_post(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Post(rctx, args["text"].(string), args["username"].(string), args["roomName"].(string))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Mutation().Post(rctx, args["text"].(string), args["username"].(string), args["roomName"].(string))
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -713,11 +728,12 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
 		case "room":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_room(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -737,7 +753,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 func (ec *executionContext) _Query_room(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_room_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -752,7 +768,9 @@ func (ec *executionContext) _Query_room(ctx context.Context, field graphql.Colle
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Room(rctx, args["name"].(string))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Room(rctx, args["name"].(string))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -772,7 +790,7 @@ func (ec *executionContext) _Query_room(ctx context.Context, field graphql.Colle
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query___type_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -854,7 +872,7 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 }
 
 func (ec *executionContext) _Subscription_messageAdded(ctx context.Context, field graphql.CollectedField) func() graphql.Marshaler {
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Subscription_messageAdded_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1065,7 +1083,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1370,7 +1388,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1717,7 +1735,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1870,7 +1888,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2007,7 +2025,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_fields_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2058,7 +2076,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2115,7 +2133,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2172,7 +2190,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2184,7 +2202,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_enumValues_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2235,7 +2253,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2292,7 +2310,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2336,6 +2354,37 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 			ret = nil
 		}
 	}()
+	rctx := graphql.GetResolverContext(ctx)
+	timeout := ec.ResolverTimeout
+	for _, d := range rctx.Field.Definition.Directives {
+		if d.Name == "timeout" {
+			ms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)["ms"])
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
+			}
+			timeout = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives in the query run outside of those in the schema
+	for _, d := range rctx.Field.QueryDirectives() {
+		var err error
+		next, err = ec.directive(obj, d, d.Location, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	if timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {
+		ctx = graphql.WithFieldTimeout(ctx, timeout)
+	}
 	res, err := ec.ResolverMiddleware(ctx, next)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2344,6 +2393,28 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 	return res
 }
 
+// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one
+func (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {
+	switch d.Name {
+	}
+	return next, nil
+}
+
+// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value
+func (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {
+	next := func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+	for _, d := range directives {
+		var err error
+		next, err = ec.directive(obj, d, location, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
+
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -18,9 +19,10 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
-		resolvers:  cfg.Resolvers,
-		directives: cfg.Directives,
-		complexity: cfg.Complexity,
+		resolvers:        cfg.Resolvers,
+		directives:       cfg.Directives,
+		complexity:       cfg.Complexity,
+		concurrencyLimit: cfg.ConcurrencyLimit,
 	}
 }
 
@@ -28,6 +30,9 @@ type Config struct {
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
+	// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,
+	// it is used when the request context doesn't set its own limit.
+	ConcurrencyLimit int
 }
 
 type ResolverRoot interface {
@@ -133,9 +138,10 @@ func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]in
 }
 
 type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
+	resolvers        ResolverRoot
+	directives       DirectiveRoot
+	complexity       ComplexityRoot
+	concurrencyLimit int
 }
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -218,7 +224,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Query(ctx, op.SelectionSet)
@@ -234,7 +240,7 @@ func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinitio
 }
 
 func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Mutation(ctx, op.SelectionSet)
@@ -259,6 +265,14 @@ type executionContext struct {
 	*executableSchema
 }
 
+func (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	if ec.ConcurrencyLimit == 0 {
+		ec.ConcurrencyLimit = e.concurrencyLimit
+	}
+	return ec
+}
+
 var mutationImplementors = []string{"Mutation"}
 
 // nolint: gocyclo, errcheck, gas, goconst
@@ -297,7 +311,7 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 func (ec *executionContext) _Mutation_createTodo(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Mutation_createTodo_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -312,7 +326,9 @@ func (ec *executionContext) _Mutation_createTodo(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateTodo(rctx, args["input"].(NewTodo))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Mutation().CreateTodo(rctx, args["input"].(NewTodo))
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -347,14 +363,15 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
 		case "todos":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_todos(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -383,7 +400,9 @@ func (ec *executionContext) _Query_todos(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Todos(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Todos(rctx)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -422,7 +441,7 @@ func (ec *executionContext) _Query_todos(ctx context.Context, field graphql.Coll
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -434,7 +453,7 @@ func (ec *executionContext) _Query_todos(ctx context.Context, field graphql.Coll
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query___type_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -510,14 +529,15 @@ func (ec *executionContext) _Todo(ctx context.Context, sel ast.SelectionSet, obj
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Todo")
 		case "id":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Todo_id(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "databaseId":
 			out.Values[i] = ec._Todo_databaseId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -562,7 +582,9 @@ func (ec *executionContext) _Todo_id(ctx context.Context, field graphql.Collecte
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Todo().ID(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Todo().ID(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -955,7 +977,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1260,7 +1282,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1607,7 +1629,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1760,7 +1782,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1897,7 +1919,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_fields_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1948,7 +1970,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2005,7 +2027,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2062,7 +2084,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2074,7 +2096,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_enumValues_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2125,7 +2147,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2182,7 +2204,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2250,6 +2272,37 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 			ret = nil
 		}
 	}()
+	rctx := graphql.GetResolverContext(ctx)
+	timeout := ec.ResolverTimeout
+	for _, d := range rctx.Field.Definition.Directives {
+		if d.Name == "timeout" {
+			ms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)["ms"])
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
+			}
+			timeout = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives in the query run outside of those in the schema
+	for _, d := range rctx.Field.QueryDirectives() {
+		var err error
+		next, err = ec.directive(obj, d, d.Location, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	if timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {
+		ctx = graphql.WithFieldTimeout(ctx, timeout)
+	}
 	res, err := ec.ResolverMiddleware(ctx, next)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2258,6 +2311,28 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 	return res
 }
 
+// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one
+func (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {
+	switch d.Name {
+	}
+	return next, nil
+}
+
+// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value
+func (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {
+	next := func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+	for _, d := range directives {
+		var err error
+		next, err = ec.directive(obj, d, location, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
+
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
@@ -2297,7 +2372,7 @@ input NewTodo {
 `},
 	&ast.Source{Name: "user.graphql", Input: `type User {
   id: ID!
-  name: String!
+  name: String! # Method
 }
 `},
 )
@@ -1,7 +1,9 @@
 package dataloader
 
 import (
+	"context"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/99designs/gqlgen/client"
@@ -11,7 +13,10 @@ import (
 )
 
 func TestTodo(t *testing.T) {
-	srv := httptest.NewServer(LoaderMiddleware(handler.GraphQL(NewExecutableSchema(Config{Resolvers: &Resolver{}}))))
+	srv := httptest.NewServer(handler.GraphQL(
+		NewExecutableSchema(Config{Resolvers: &Resolver{}}),
+		handler.RequestMiddleware(LoadersMiddleware(LoaderConfig())),
+	))
 	c := client.New(srv.URL)
 
 	t.Run("create a new todo", func(t *testing.T) {
@@ -88,3 +93,74 @@ func TestTodo(t *testing.T) {
 	})
 
 }
+
+func TestLoaders(t *testing.T) {
+	var batches [][]int
+	var mu sync.Mutex
+	fetch := func(ctx context.Context, keys []int) ([]*Address, []error) {
+		mu.Lock()
+		batches = append(batches, keys)
+		mu.Unlock()
+
+		addresses := make([]*Address, len(keys))
+		for i, key := range keys {
+			addresses[i] = &Address{ID: key}
+		}
+		return addresses, nil
+	}
+
+	t.Run("batches and dedupes keys", func(t *testing.T) {
+		batches = nil
+		loader := NewAddressLoader(context.Background(), AddressLoaderConfig{Fetch: fetch})
+
+		addresses, errs := loader.LoadAll([]int{1, 2, 1, 3})
+		require.Equal(t, []error{nil, nil, nil, nil}, errs)
+		require.Equal(t, []int{1, 2, 1, 3}, []int{addresses[0].ID, addresses[1].ID, addresses[2].ID, addresses[3].ID})
+		require.Equal(t, [][]int{{1, 2, 3}}, batches)
+	})
+
+	t.Run("splits batches at max batch", func(t *testing.T) {
+		batches = nil
+		loader := NewAddressLoader(context.Background(), AddressLoaderConfig{Fetch: fetch, MaxBatch: 2})
+
+		_, errs := loader.LoadAll([]int{1, 2, 3})
+		require.Equal(t, []error{nil, nil, nil}, errs)
+		require.Len(t, batches, 2)
+	})
+
+	t.Run("primed values are not fetched", func(t *testing.T) {
+		batches = nil
+		loader := NewAddressLoader(context.Background(), AddressLoaderConfig{Fetch: fetch})
+
+		require.True(t, loader.Prime(1, &Address{ID: 1, Street: "primed"}))
+		require.False(t, loader.Prime(1, &Address{ID: 1, Street: "ignored"}))
+
+		address, err := loader.Load(1)
+		require.NoError(t, err)
+		require.Equal(t, "primed", address.Street)
+		require.Empty(t, batches)
+
+		loader.ForcePrime(1, &Address{ID: 1, Street: "forced"})
+		address, err = loader.Load(1)
+		require.NoError(t, err)
+		require.Equal(t, "forced", address.Street)
+
+		loader.Clear(1)
+		address, err = loader.Load(1)
+		require.NoError(t, err)
+		require.Equal(t, "", address.Street)
+		require.Equal(t, [][]int{{1}}, batches)
+	})
+
+	t.Run("registry is available in resolvers", func(t *testing.T) {
+		var loaders *Loaders
+		LoadersMiddleware(LoaderConfig())(context.Background(), func(ctx context.Context) []byte {
+			loaders = GetLoaders(ctx)
+			return nil
+		})
+		require.NotNil(t, loaders)
+		require.NotNil(t, loaders.Address)
+		require.NotNil(t, loaders.OrdersByCustomer)
+		require.NotNil(t, loaders.ItemsByOrder)
+	})
+}
@@ -1,41 +1,21 @@
-//go:generate gorunpkg github.com/vektah/dataloaden -keys int github.com/99designs/gqlgen/example/dataloader.Address
-//go:generate gorunpkg github.com/vektah/dataloaden -keys int -slice github.com/99designs/gqlgen/example/dataloader.Order
-//go:generate gorunpkg github.com/vektah/dataloaden -keys int -slice github.com/99designs/gqlgen/example/dataloader.Item
-
 package dataloader
 
 import (
 	"context"
 	"fmt"
 	"math/rand"
-	"net/http"
 	"strconv"
 	"strings"
 	"time"
 )
 
-type ctxKeyType struct{ name string }
-
-var ctxKey = ctxKeyType{"userCtx"}
-
-type loaders struct {
-	addressByID      *AddressLoader
-	ordersByCustomer *OrderSliceLoader
-	itemsByOrder     *ItemSliceLoader
-}
-
-func LoaderMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ldrs := loaders{}
-
-		// set this to zero what happens without dataloading
-		wait := 250 * time.Microsecond
-
+// LoaderConfig provides the fetch functions for the loaders declared in .gqlgen.yml. Set the wait on each
+// loader to a negative duration to see what happens without dataloading.
+func LoaderConfig() LoadersConfig {
+	return LoadersConfig{
 		// simple 1:1 loader, fetch an address by its primary key
-		ldrs.addressByID = &AddressLoader{
-			wait:     wait,
-			maxBatch: 100,
-			fetch: func(keys []int) ([]*Address, []error) {
+		Address: AddressLoaderConfig{
+			Fetch: func(ctx context.Context, keys []int) ([]*Address, []error) {
 				var keySql []string
 				for _, key := range keys {
 					keySql = append(keySql, strconv.Itoa(key))
@@ -51,13 +31,11 @@ func LoaderMiddleware(next http.Handler) http.Handler {
 				}
 				return addresses, errors
 			},
-		}
+		},
 
 		// 1:M loader
-		ldrs.ordersByCustomer = &OrderSliceLoader{
-			wait:     wait,
-			maxBatch: 100,
-			fetch: func(keys []int) ([][]Order, []error) {
+		OrdersByCustomer: OrdersByCustomerLoaderConfig{
+			Fetch: func(ctx context.Context, keys []int) ([][]Order, []error) {
 				var keySql []string
 				for _, key := range keys {
 					keySql = append(keySql, strconv.Itoa(key))
@@ -75,19 +53,17 @@ func LoaderMiddleware(next http.Handler) http.Handler {
 						{ID: id + 1, Amount: rand.Float64(), Date: time.Now().Add(-time.Duration(key) * time.Hour)},
 					}
 
-					// if you had another customer loader you would prime its cache here
-					// by calling `ldrs.ordersByID.Prime(id, orders[i])`
+					// if you had another order loader you would prime its cache here
+					// by calling `GetLoaders(ctx).OrderByID.Prime(id, &orders[i][0])`
 				}
 
 				return orders, errors
 			},
-		}
+		},
 
 		// M:M loader
-		ldrs.itemsByOrder = &ItemSliceLoader{
-			wait:     wait,
-			maxBatch: 100,
-			fetch: func(keys []int) ([][]Item, []error) {
+		ItemsByOrder: ItemsByOrderLoaderConfig{
+			Fetch: func(ctx context.Context, keys []int) ([][]Item, []error) {
 				var keySql []string
 				for _, key := range keys {
 					keySql = append(keySql, strconv.Itoa(key))
@@ -107,13 +83,6 @@ func LoaderMiddleware(next http.Handler) http.Handler {
 
 				return items, errors
 			},
-		}
-
-		dlCtx := context.WithValue(r.Context(), ctxKey, ldrs)
-		next.ServeHTTP(w, r.WithContext(dlCtx))
-	})
-}
-
-func ctxLoaders(ctx context.Context) loaders {
-	return ctx.Value(ctxKey).(loaders)
+		},
+	}
 }
@@ -19,9 +19,10 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
-		resolvers:  cfg.Resolvers,
-		directives: cfg.Directives,
-		complexity: cfg.Complexity,
+		resolvers:        cfg.Resolvers,
+		directives:       cfg.Directives,
+		complexity:       cfg.Complexity,
+		concurrencyLimit: cfg.ConcurrencyLimit,
 	}
 }
 
@@ -29,6 +30,9 @@ type Config struct {
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
+	// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,
+	// it is used when the request context doesn't set its own limit.
+	ConcurrencyLimit int
 }
 
 type ResolverRoot interface {
@@ -194,9 +198,10 @@ func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]in
 }
 
 type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
+	resolvers        ResolverRoot
+	directives       DirectiveRoot
+	complexity       ComplexityRoot
+	concurrencyLimit int
 }
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -326,7 +331,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Query(ctx, op.SelectionSet)
@@ -354,6 +359,14 @@ type executionContext struct {
 	*executableSchema
 }
 
+func (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	if ec.ConcurrencyLimit == 0 {
+		ec.ConcurrencyLimit = e.concurrencyLimit
+	}
+	return ec
+}
+
 var addressImplementors = []string{"Address"}
 
 // nolint: gocyclo, errcheck, gas, goconst
@@ -501,17 +514,19 @@ func (ec *executionContext) _Customer(ctx context.Context, sel ast.SelectionSet,
 				invalid = true
 			}
 		case "address":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Customer_address(ctx, field, obj)
 				wg.Done()
-			}(i, field)
+			})
 		case "orders":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Customer_orders(ctx, field, obj)
 				wg.Done()
-			}(i, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -590,7 +605,9 @@ func (ec *executionContext) _Customer_address(ctx context.Context, field graphql
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Customer().Address(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Customer().Address(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -619,7 +636,9 @@ func (ec *executionContext) _Customer_orders(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Customer().Orders(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Customer().Orders(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -655,7 +674,7 @@ func (ec *executionContext) _Customer_orders(ctx context.Context, field graphql.
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -751,11 +770,12 @@ func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, ob
 				invalid = true
 			}
 		case "items":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Order_items(ctx, field, obj)
 				wg.Done()
-			}(i, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -861,7 +881,9 @@ func (ec *executionContext) _Order_items(ctx context.Context, field graphql.Coll
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Order().Items(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Order().Items(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -897,7 +919,7 @@ func (ec *executionContext) _Order_items(ctx context.Context, field graphql.Coll
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -925,23 +947,26 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
 		case "customers":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_customers(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "torture1d":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_torture1d(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "torture2d":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_torture2d(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -970,7 +995,9 @@ func (ec *executionContext) _Query_customers(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Customers(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Customers(rctx)
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1006,7 +1033,7 @@ func (ec *executionContext) _Query_customers(ctx context.Context, field graphql.
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1018,7 +1045,7 @@ func (ec *executionContext) _Query_customers(ctx context.Context, field graphql.
 func (ec *executionContext) _Query_torture1d(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_torture1d_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1033,7 +1060,9 @@ func (ec *executionContext) _Query_torture1d(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Torture1d(rctx, args["customerIds"].([]int))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Torture1d(rctx, args["customerIds"].([]int))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1069,7 +1098,7 @@ func (ec *executionContext) _Query_torture1d(ctx context.Context, field graphql.
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1081,7 +1110,7 @@ func (ec *executionContext) _Query_torture1d(ctx context.Context, field graphql.
 func (ec *executionContext) _Query_torture2d(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_torture2d_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1096,7 +1125,9 @@ func (ec *executionContext) _Query_torture2d(ctx context.Context, field graphql.
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Torture2d(rctx, args["customerIds"].([][]int))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Torture2d(rctx, args["customerIds"].([][]int))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -1152,7 +1183,7 @@ func (ec *executionContext) _Query_torture2d(ctx context.Context, field graphql.
 					if isLen1 {
 						f(idx2)
 					} else {
-						go f(idx2)
+						ec.Go(func() { f(idx2) })
 					}
 
 				}
@@ -1163,7 +1194,7 @@ func (ec *executionContext) _Query_torture2d(ctx context.Context, field graphql.
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1175,7 +1206,7 @@ func (ec *executionContext) _Query_torture2d(ctx context.Context, field graphql.
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query___type_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1416,7 +1447,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1721,7 +1752,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2068,7 +2099,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2221,7 +2252,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2358,7 +2389,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_fields_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2409,7 +2440,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2466,7 +2497,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2523,7 +2554,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2535,7 +2566,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_enumValues_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2586,7 +2617,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2643,7 +2674,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2687,6 +2718,37 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 			ret = nil
 		}
 	}()
+	rctx := graphql.GetResolverContext(ctx)
+	timeout := ec.ResolverTimeout
+	for _, d := range rctx.Field.Definition.Directives {
+		if d.Name == "timeout" {
+			ms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)["ms"])
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
+			}
+			timeout = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives in the query run outside of those in the schema
+	for _, d := range rctx.Field.QueryDirectives() {
+		var err error
+		next, err = ec.directive(obj, d, d.Location, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	if timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {
+		ctx = graphql.WithFieldTimeout(ctx, timeout)
+	}
 	res, err := ec.ResolverMiddleware(ctx, next)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2695,6 +2757,28 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 	return res
 }
 
+// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one
+func (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {
+	switch d.Name {
+	}
+	return next, nil
+}
+
+// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value
+func (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {
+	next := func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+	for _, d := range directives {
+		var err error
+		next, err = ec.directive(obj, d, location, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
+
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
@@ -0,0 +1,756 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// LoadersConfig provides the fetch functions and batching options for every loader in the registry.
+type LoadersConfig struct {
+	Address          AddressLoaderConfig
+	ItemsByOrder     ItemsByOrderLoaderConfig
+	OrdersByCustomer OrdersByCustomerLoaderConfig
+}
+
+// Loaders is a registry of dataloaders, a new registry should be created for every request so that
+// cached values are never shared between users.
+type Loaders struct {
+	Address          *AddressLoader
+	ItemsByOrder     *ItemsByOrderLoader
+	OrdersByCustomer *OrdersByCustomerLoader
+}
+
+// NewLoaders creates a new set of loaders. Fetch functions will be called with ctx.
+func NewLoaders(ctx context.Context, cfg LoadersConfig) *Loaders {
+	return &Loaders{
+		Address:          NewAddressLoader(ctx, cfg.Address),
+		ItemsByOrder:     NewItemsByOrderLoader(ctx, cfg.ItemsByOrder),
+		OrdersByCustomer: NewOrdersByCustomerLoader(ctx, cfg.OrdersByCustomer),
+	}
+}
+
+type loadersCtxKey struct{}
+
+// WithLoaders attaches a loader registry to the context.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, loaders)
+}
+
+// GetLoaders returns the loader registry for the current request, or nil if LoadersMiddleware is not in use.
+func GetLoaders(ctx context.Context) *Loaders {
+	loaders, _ := ctx.Value(loadersCtxKey{}).(*Loaders)
+	return loaders
+}
+
+// LoadersMiddleware creates a new loader registry for every request, install it with handler.RequestMiddleware.
+func LoadersMiddleware(cfg LoadersConfig) graphql.RequestMiddleware {
+	return func(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+		return next(WithLoaders(ctx, NewLoaders(ctx, cfg)))
+	}
+}
+
+// AddressLoaderConfig captures the config to create a new AddressLoader
+type AddressLoaderConfig struct {
+	// Fetch is a method that provides the data for the loader. It must return a value for every key,
+	// in the same order as the keys, or a single error for the whole batch.
+	Fetch func(ctx context.Context, keys []int) ([]*Address, []error)
+
+	// Wait is how long to wait for more keys before sending a batch, defaults to 250µs
+	Wait time.Duration
+
+	// MaxBatch will limit the maximum number of keys to send in one batch, defaults to 100
+	MaxBatch int
+}
+
+// NewAddressLoader creates a new AddressLoader given a fetch, wait, and maxBatch
+func NewAddressLoader(ctx context.Context, cfg AddressLoaderConfig) *AddressLoader {
+	if cfg.Wait == 0 {
+		cfg.Wait = time.Duration(250000)
+	}
+	if cfg.MaxBatch == 0 {
+		cfg.MaxBatch = 100
+	}
+	return &AddressLoader{
+		ctx:      ctx,
+		fetch:    cfg.Fetch,
+		wait:     cfg.Wait,
+		maxBatch: cfg.MaxBatch,
+	}
+}
+
+// AddressLoader batches and caches requests
+type AddressLoader struct {
+	// the context passed to fetch
+	ctx context.Context
+
+	// this method provides the data for the loader
+	fetch func(ctx context.Context, keys []int) ([]*Address, []error)
+
+	// how long to wait before sending a batch
+	wait time.Duration
+
+	// this will limit the maximum number of keys to send in one batch, 0 = no limit
+	maxBatch int
+
+	// INTERNAL
+
+	// lazily created cache
+	cache map[int]*Address
+
+	// the current batch. keys will continue to be collected until timeout is hit,
+	// then everything will be sent to the fetch method and out to the listeners
+	batch *addressBatch
+
+	// mutex to prevent races
+	mu sync.Mutex
+}
+
+type addressBatch struct {
+	keys    []int
+	data    []*Address
+	error   []error
+	closing bool
+	done    chan struct{}
+}
+
+// Load a Address by key, batching and caching will be applied automatically
+func (l *AddressLoader) Load(key int) (*Address, error) {
+	return l.LoadThunk(key)()
+}
+
+// LoadThunk returns a function that when called will block waiting for a Address.
+// This method should be used if you want one goroutine to make requests to many
+// different data loaders without blocking until the thunk is called.
+func (l *AddressLoader) LoadThunk(key int) func() (*Address, error) {
+	l.mu.Lock()
+	if it, ok := l.cache[key]; ok {
+		l.mu.Unlock()
+		return func() (*Address, error) {
+			return it, nil
+		}
+	}
+	if l.batch == nil {
+		l.batch = &addressBatch{done: make(chan struct{})}
+	}
+	batch := l.batch
+	pos := batch.keyIndex(l, key)
+	l.mu.Unlock()
+
+	return func() (*Address, error) {
+		<-batch.done
+
+		var data *Address
+		if pos < len(batch.data) {
+			data = batch.data[pos]
+		}
+
+		var err error
+		// its convenient to be able to return a single error for everything
+		if len(batch.error) == 1 {
+			err = batch.error[0]
+		} else if batch.error != nil {
+			err = batch.error[pos]
+		}
+
+		if err == nil {
+			l.mu.Lock()
+			l.unsafeSet(key, data)
+			l.mu.Unlock()
+		}
+
+		return data, err
+	}
+}
+
+// LoadAll fetches many keys at once. It will be broken into appropriate sized
+// sub batches depending on how the loader is configured
+func (l *AddressLoader) LoadAll(keys []int) ([]*Address, []error) {
+	results := make([]func() (*Address, error), len(keys))
+
+	for i, key := range keys {
+		results[i] = l.LoadThunk(key)
+	}
+
+	values := make([]*Address, len(keys))
+	errors := make([]error, len(keys))
+	for i, thunk := range results {
+		values[i], errors[i] = thunk()
+	}
+	return values, errors
+}
+
+// Prime the cache with the provided key and value. If the key already exists, no change is made
+// and false is returned.
+// (To forcefully prime the cache, clear the key first with loader.Clear(key) or use ForcePrime.)
+func (l *AddressLoader) Prime(key int, value *Address) bool {
+	l.mu.Lock()
+	var found bool
+	if _, found = l.cache[key]; !found {
+		l.unsafeSet(key, l.clone(value))
+	}
+	l.mu.Unlock()
+	return !found
+}
+
+// PrimeAll primes the cache with many values at once, skipping any keys that already exist.
+// keys and values must be the same length.
+func (l *AddressLoader) PrimeAll(keys []int, values []*Address) {
+	l.mu.Lock()
+	for i, key := range keys {
+		if _, found := l.cache[key]; !found {
+			l.unsafeSet(key, l.clone(values[i]))
+		}
+	}
+	l.mu.Unlock()
+}
+
+// ForcePrime sets the value for key in the cache, replacing any existing value.
+func (l *AddressLoader) ForcePrime(key int, value *Address) {
+	l.mu.Lock()
+	l.unsafeSet(key, l.clone(value))
+	l.mu.Unlock()
+}
+
+// Clear the value at key from the cache, if it exists
+func (l *AddressLoader) Clear(key int) {
+	l.mu.Lock()
+	delete(l.cache, key)
+	l.mu.Unlock()
+}
+
+// clone makes a copy when writing to the cache, its easy to pass a pointer in from a loop var
+// and end up with the whole cache pointing to the same value.
+func (l *AddressLoader) clone(value *Address) *Address {
+	if value == nil {
+		return nil
+	}
+	cpy := *value
+	return &cpy
+}
+
+func (l *AddressLoader) unsafeSet(key int, value *Address) {
+	if l.cache == nil {
+		l.cache = map[int]*Address{}
+	}
+	l.cache[key] = value
+}
+
+// keyIndex will return the location of the key in the batch, if its not found
+// it will add the key to the batch
+func (b *addressBatch) keyIndex(l *AddressLoader, key int) int {
+	for i, existingKey := range b.keys {
+		if key == existingKey {
+			return i
+		}
+	}
+
+	pos := len(b.keys)
+	b.keys = append(b.keys, key)
+	if pos == 0 {
+		go b.startTimer(l)
+	}
+
+	if l.maxBatch != 0 && pos >= l.maxBatch-1 {
+		if !b.closing {
+			b.closing = true
+			l.batch = nil
+			go b.end(l)
+		}
+	}
+
+	return pos
+}
+
+func (b *addressBatch) startTimer(l *AddressLoader) {
+	time.Sleep(l.wait)
+	l.mu.Lock()
+
+	// we must have hit a batch limit and are already finalizing this batch
+	if b.closing {
+		l.mu.Unlock()
+		return
+	}
+
+	l.batch = nil
+	l.mu.Unlock()
+
+	b.end(l)
+}
+
+func (b *addressBatch) end(l *AddressLoader) {
+	b.data, b.error = l.fetch(l.ctx, b.keys)
+	close(b.done)
+}
+
+// ItemsByOrderLoaderConfig captures the config to create a new ItemsByOrderLoader
+type ItemsByOrderLoaderConfig struct {
+	// Fetch is a method that provides the data for the loader. It must return a value for every key,
+	// in the same order as the keys, or a single error for the whole batch.
+	Fetch func(ctx context.Context, keys []int) ([][]Item, []error)
+
+	// Wait is how long to wait for more keys before sending a batch, defaults to 250µs
+	Wait time.Duration
+
+	// MaxBatch will limit the maximum number of keys to send in one batch, defaults to 100
+	MaxBatch int
+}
+
+// NewItemsByOrderLoader creates a new ItemsByOrderLoader given a fetch, wait, and maxBatch
+func NewItemsByOrderLoader(ctx context.Context, cfg ItemsByOrderLoaderConfig) *ItemsByOrderLoader {
+	if cfg.Wait == 0 {
+		cfg.Wait = time.Duration(250000)
+	}
+	if cfg.MaxBatch == 0 {
+		cfg.MaxBatch = 100
+	}
+	return &ItemsByOrderLoader{
+		ctx:      ctx,
+		fetch:    cfg.Fetch,
+		wait:     cfg.Wait,
+		maxBatch: cfg.MaxBatch,
+	}
+}
+
+// ItemsByOrderLoader batches and caches requests
+type ItemsByOrderLoader struct {
+	// the context passed to fetch
+	ctx context.Context
+
+	// this method provides the data for the loader
+	fetch func(ctx context.Context, keys []int) ([][]Item, []error)
+
+	// how long to wait before sending a batch
+	wait time.Duration
+
+	// this will limit the maximum number of keys to send in one batch, 0 = no limit
+	maxBatch int
+
+	// INTERNAL
+
+	// lazily created cache
+	cache map[int][]Item
+
+	// the current batch. keys will continue to be collected until timeout is hit,
+	// then everything will be sent to the fetch method and out to the listeners
+	batch *itemsByOrderBatch
+
+	// mutex to prevent races
+	mu sync.Mutex
+}
+
+type itemsByOrderBatch struct {
+	keys    []int
+	data    [][]Item
+	error   []error
+	closing bool
+	done    chan struct{}
+}
+
+// Load a ItemsByOrder by key, batching and caching will be applied automatically
+func (l *ItemsByOrderLoader) Load(key int) ([]Item, error) {
+	return l.LoadThunk(key)()
+}
+
+// LoadThunk returns a function that when called will block waiting for a ItemsByOrder.
+// This method should be used if you want one goroutine to make requests to many
+// different data loaders without blocking until the thunk is called.
+func (l *ItemsByOrderLoader) LoadThunk(key int) func() ([]Item, error) {
+	l.mu.Lock()
+	if it, ok := l.cache[key]; ok {
+		l.mu.Unlock()
+		return func() ([]Item, error) {
+			return it, nil
+		}
+	}
+	if l.batch == nil {
+		l.batch = &itemsByOrderBatch{done: make(chan struct{})}
+	}
+	batch := l.batch
+	pos := batch.keyIndex(l, key)
+	l.mu.Unlock()
+
+	return func() ([]Item, error) {
+		<-batch.done
+
+		var data []Item
+		if pos < len(batch.data) {
+			data = batch.data[pos]
+		}
+
+		var err error
+		// its convenient to be able to return a single error for everything
+		if len(batch.error) == 1 {
+			err = batch.error[0]
+		} else if batch.error != nil {
+			err = batch.error[pos]
+		}
+
+		if err == nil {
+			l.mu.Lock()
+			l.unsafeSet(key, data)
+			l.mu.Unlock()
+		}
+
+		return data, err
+	}
+}
+
+// LoadAll fetches many keys at once. It will be broken into appropriate sized
+// sub batches depending on how the loader is configured
+func (l *ItemsByOrderLoader) LoadAll(keys []int) ([][]Item, []error) {
+	results := make([]func() ([]Item, error), len(keys))
+
+	for i, key := range keys {
+		results[i] = l.LoadThunk(key)
+	}
+
+	values := make([][]Item, len(keys))
+	errors := make([]error, len(keys))
+	for i, thunk := range results {
+		values[i], errors[i] = thunk()
+	}
+	return values, errors
+}
+
+// Prime the cache with the provided key and value. If the key already exists, no change is made
+// and false is returned.
+// (To forcefully prime the cache, clear the key first with loader.Clear(key) or use ForcePrime.)
+func (l *ItemsByOrderLoader) Prime(key int, value []Item) bool {
+	l.mu.Lock()
+	var found bool
+	if _, found = l.cache[key]; !found {
+		l.unsafeSet(key, l.clone(value))
+	}
+	l.mu.Unlock()
+	return !found
+}
+
+// PrimeAll primes the cache with many values at once, skipping any keys that already exist.
+// keys and values must be the same length.
+func (l *ItemsByOrderLoader) PrimeAll(keys []int, values [][]Item) {
+	l.mu.Lock()
+	for i, key := range keys {
+		if _, found := l.cache[key]; !found {
+			l.unsafeSet(key, l.clone(values[i]))
+		}
+	}
+	l.mu.Unlock()
+}
+
+// ForcePrime sets the value for key in the cache, replacing any existing value.
+func (l *ItemsByOrderLoader) ForcePrime(key int, value []Item) {
+	l.mu.Lock()
+	l.unsafeSet(key, l.clone(value))
+	l.mu.Unlock()
+}
+
+// Clear the value at key from the cache, if it exists
+func (l *ItemsByOrderLoader) Clear(key int) {
+	l.mu.Lock()
+	delete(l.cache, key)
+	l.mu.Unlock()
+}
+
+// clone makes a copy when writing to the cache, its easy to pass a pointer in from a loop var
+// and end up with the whole cache pointing to the same value.
+func (l *ItemsByOrderLoader) clone(value []Item) []Item {
+	if value == nil {
+		return nil
+	}
+	cpy := make([]Item, len(value))
+	copy(cpy, value)
+	return cpy
+}
+
+func (l *ItemsByOrderLoader) unsafeSet(key int, value []Item) {
+	if l.cache == nil {
+		l.cache = map[int][]Item{}
+	}
+	l.cache[key] = value
+}
+
+// keyIndex will return the location of the key in the batch, if its not found
+// it will add the key to the batch
+func (b *itemsByOrderBatch) keyIndex(l *ItemsByOrderLoader, key int) int {
+	for i, existingKey := range b.keys {
+		if key == existingKey {
+			return i
+		}
+	}
+
+	pos := len(b.keys)
+	b.keys = append(b.keys, key)
+	if pos == 0 {
+		go b.startTimer(l)
+	}
+
+	if l.maxBatch != 0 && pos >= l.maxBatch-1 {
+		if !b.closing {
+			b.closing = true
+			l.batch = nil
+			go b.end(l)
+		}
+	}
+
+	return pos
+}
+
+func (b *itemsByOrderBatch) startTimer(l *ItemsByOrderLoader) {
+	time.Sleep(l.wait)
+	l.mu.Lock()
+
+	// we must have hit a batch limit and are already finalizing this batch
+	if b.closing {
+		l.mu.Unlock()
+		return
+	}
+
+	l.batch = nil
+	l.mu.Unlock()
+
+	b.end(l)
+}
+
+func (b *itemsByOrderBatch) end(l *ItemsByOrderLoader) {
+	b.data, b.error = l.fetch(l.ctx, b.keys)
+	close(b.done)
+}
+
+// OrdersByCustomerLoaderConfig captures the config to create a new OrdersByCustomerLoader
+type OrdersByCustomerLoaderConfig struct {
+	// Fetch is a method that provides the data for the loader. It must return a value for every key,
+	// in the same order as the keys, or a single error for the whole batch.
+	Fetch func(ctx context.Context, keys []int) ([][]Order, []error)
+
+	// Wait is how long to wait for more keys before sending a batch, defaults to 250µs
+	Wait time.Duration
+
+	// MaxBatch will limit the maximum number of keys to send in one batch, defaults to 100
+	MaxBatch int
+}
+
+// NewOrdersByCustomerLoader creates a new OrdersByCustomerLoader given a fetch, wait, and maxBatch
+func NewOrdersByCustomerLoader(ctx context.Context, cfg OrdersByCustomerLoaderConfig) *OrdersByCustomerLoader {
+	if cfg.Wait == 0 {
+		cfg.Wait = time.Duration(250000)
+	}
+	if cfg.MaxBatch == 0 {
+		cfg.MaxBatch = 100
+	}
+	return &OrdersByCustomerLoader{
+		ctx:      ctx,
+		fetch:    cfg.Fetch,
+		wait:     cfg.Wait,
+		maxBatch: cfg.MaxBatch,
+	}
+}
+
+// OrdersByCustomerLoader batches and caches requests
+type OrdersByCustomerLoader struct {
+	// the context passed to fetch
+	ctx context.Context
+
+	// this method provides the data for the loader
+	fetch func(ctx context.Context, keys []int) ([][]Order, []error)
+
+	// how long to wait before sending a batch
+	wait time.Duration
+
+	// this will limit the maximum number of keys to send in one batch, 0 = no limit
+	maxBatch int
+
+	// INTERNAL
+
+	// lazily created cache
+	cache map[int][]Order
+
+	// the current batch. keys will continue to be collected until timeout is hit,
+	// then everything will be sent to the fetch method and out to the listeners
+	batch *ordersByCustomerBatch
+
+	// mutex to prevent races
+	mu sync.Mutex
+}
+
+type ordersByCustomerBatch struct {
+	keys    []int
+	data    [][]Order
+	error   []error
+	closing bool
+	done    chan struct{}
+}
+
+// Load a OrdersByCustomer by key, batching and caching will be applied automatically
+func (l *OrdersByCustomerLoader) Load(key int) ([]Order, error) {
+	return l.LoadThunk(key)()
+}
+
+// LoadThunk returns a function that when called will block waiting for a OrdersByCustomer.
+// This method should be used if you want one goroutine to make requests to many
+// different data loaders without blocking until the thunk is called.
+func (l *OrdersByCustomerLoader) LoadThunk(key int) func() ([]Order, error) {
+	l.mu.Lock()
+	if it, ok := l.cache[key]; ok {
+		l.mu.Unlock()
+		return func() ([]Order, error) {
+			return it, nil
+		}
+	}
+	if l.batch == nil {
+		l.batch = &ordersByCustomerBatch{done: make(chan struct{})}
+	}
+	batch := l.batch
+	pos := batch.keyIndex(l, key)
+	l.mu.Unlock()
+
+	return func() ([]Order, error) {
+		<-batch.done
+
+		var data []Order
+		if pos < len(batch.data) {
+			data = batch.data[pos]
+		}
+
+		var err error
+		// its convenient to be able to return a single error for everything
+		if len(batch.error) == 1 {
+			err = batch.error[0]
+		} else if batch.error != nil {
+			err = batch.error[pos]
+		}
+
+		if err == nil {
+			l.mu.Lock()
+			l.unsafeSet(key, data)
+			l.mu.Unlock()
+		}
+
+		return data, err
+	}
+}
+
+// LoadAll fetches many keys at once. It will be broken into appropriate sized
+// sub batches depending on how the loader is configured
+func (l *OrdersByCustomerLoader) LoadAll(keys []int) ([][]Order, []error) {
+	results := make([]func() ([]Order, error), len(keys))
+
+	for i, key := range keys {
+		results[i] = l.LoadThunk(key)
+	}
+
+	values := make([][]Order, len(keys))
+	errors := make([]error, len(keys))
+	for i, thunk := range results {
+		values[i], errors[i] = thunk()
+	}
+	return values, errors
+}
+
+// Prime the cache with the provided key and value. If the key already exists, no change is made
+// and false is returned.
+// (To forcefully prime the cache, clear the key first with loader.Clear(key) or use ForcePrime.)
+func (l *OrdersByCustomerLoader) Prime(key int, value []Order) bool {
+	l.mu.Lock()
+	var found bool
+	if _, found = l.cache[key]; !found {
+		l.unsafeSet(key, l.clone(value))
+	}
+	l.mu.Unlock()
+	return !found
+}
+
+// PrimeAll primes the cache with many values at once, skipping any keys that already exist.
+// keys and values must be the same length.
+func (l *OrdersByCustomerLoader) PrimeAll(keys []int, values [][]Order) {
+	l.mu.Lock()
+	for i, key := range keys {
+		if _, found := l.cache[key]; !found {
+			l.unsafeSet(key, l.clone(values[i]))
+		}
+	}
+	l.mu.Unlock()
+}
+
+// ForcePrime sets the value for key in the cache, replacing any existing value.
+func (l *OrdersByCustomerLoader) ForcePrime(key int, value []Order) {
+	l.mu.Lock()
+	l.unsafeSet(key, l.clone(value))
+	l.mu.Unlock()
+}
+
+// Clear the value at key from the cache, if it exists
+func (l *OrdersByCustomerLoader) Clear(key int) {
+	l.mu.Lock()
+	delete(l.cache, key)
+	l.mu.Unlock()
+}
+
+// clone makes a copy when writing to the cache, its easy to pass a pointer in from a loop var
+// and end up with the whole cache pointing to the same value.
+func (l *OrdersByCustomerLoader) clone(value []Order) []Order {
+	if value == nil {
+		return nil
+	}
+	cpy := make([]Order, len(value))
+	copy(cpy, value)
+	return cpy
+}
+
+func (l *OrdersByCustomerLoader) unsafeSet(key int, value []Order) {
+	if l.cache == nil {
+		l.cache = map[int][]Order{}
+	}
+	l.cache[key] = value
+}
+
+// keyIndex will return the location of the key in the batch, if its not found
+// it will add the key to the batch
+func (b *ordersByCustomerBatch) keyIndex(l *OrdersByCustomerLoader, key int) int {
+	for i, existingKey := range b.keys {
+		if key == existingKey {
+			return i
+		}
+	}
+
+	pos := len(b.keys)
+	b.keys = append(b.keys, key)
+	if pos == 0 {
+		go b.startTimer(l)
+	}
+
+	if l.maxBatch != 0 && pos >= l.maxBatch-1 {
+		if !b.closing {
+			b.closing = true
+			l.batch = nil
+			go b.end(l)
+		}
+	}
+
+	return pos
+}
+
+func (b *ordersByCustomerBatch) startTimer(l *OrdersByCustomerLoader) {
+	time.Sleep(l.wait)
+	l.mu.Lock()
+
+	// we must have hit a batch limit and are already finalizing this batch
+	if b.closing {
+		l.mu.Unlock()
+		return
+	}
+
+	l.batch = nil
+	l.mu.Unlock()
+
+	b.end(l)
+}
+
+func (b *ordersByCustomerBatch) end(l *OrdersByCustomerLoader) {
+	b.data, b.error = l.fetch(l.ctx, b.keys)
+	close(b.done)
+}
@@ -37,17 +37,17 @@ func (r *Resolver) Query() QueryResolver {
 type customerResolver struct{ *Resolver }
 
 func (r *customerResolver) Address(ctx context.Context, obj *Customer) (*Address, error) {
-	return ctxLoaders(ctx).addressByID.Load(obj.AddressID)
+	return GetLoaders(ctx).Address.Load(obj.AddressID)
 }
 
 func (r *customerResolver) Orders(ctx context.Context, obj *Customer) ([]Order, error) {
-	return ctxLoaders(ctx).ordersByCustomer.Load(obj.ID)
+	return GetLoaders(ctx).OrdersByCustomer.Load(obj.ID)
 }
 
 type orderResolver struct{ *Resolver }
 
 func (r *orderResolver) Items(ctx context.Context, obj *Order) ([]Item, error) {
-	return ctxLoaders(ctx).itemsByOrder.Load(obj.ID)
+	return GetLoaders(ctx).ItemsByOrder.Load(obj.ID)
 }
 
 type queryResolver struct{ *Resolver }
@@ -11,11 +11,11 @@ import (
 
 func main() {
 	router := chi.NewRouter()
-	router.Use(dataloader.LoaderMiddleware)
 
 	router.Handle("/", handler.Playground("Dataloader", "/query"))
 	router.Handle("/query", handler.GraphQL(
 		dataloader.NewExecutableSchema(dataloader.Config{Resolvers: &dataloader.Resolver{}}),
+		handler.RequestMiddleware(dataloader.LoadersMiddleware(dataloader.LoaderConfig())),
 	))
 
 	log.Println("connect to http://localhost:8082/ for graphql playground")
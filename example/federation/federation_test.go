@@ -0,0 +1,100 @@
+package federation
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFederation(t *testing.T) {
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(Config{Resolvers: &Resolver{}})))
+	c := client.New(srv.URL)
+
+	entitiesQuery := `query($representations: [_Any!]!) {
+		_entities(representations: $representations) {
+			__typename
+			... on Product { upc name price }
+			... on User { id greeting reviews { body product { name } } }
+		}
+	}`
+
+	type entities struct {
+		Entities []*struct {
+			Typename string `json:"__typename"`
+			Upc      string
+			Name     string
+			Price    int
+			ID       string
+			Greeting string
+			Reviews  []struct {
+				Body    string
+				Product struct{ Name string }
+			}
+		} `json:"_entities"`
+	}
+
+	t.Run("sdl", func(t *testing.T) {
+		var resp struct {
+			Service struct{ SDL string } `json:"_service"`
+		}
+		c.MustPost(`{ _service { sdl } }`, &resp)
+		require.Contains(t, resp.Service.SDL, `type Product @key(fields: "upc") @key(fields: "sku") {`)
+		require.Contains(t, resp.Service.SDL, `type User @key(fields: "id") @extends {`)
+		require.NotContains(t, resp.Service.SDL, "_Entity")
+		require.NotContains(t, resp.Service.SDL, "_entities")
+	})
+
+	t.Run("find entities by each of their keys", func(t *testing.T) {
+		var resp entities
+		c.MustPost(entitiesQuery, &resp, client.Var("representations", []map[string]interface{}{
+			{"__typename": "Product", "upc": "2"},
+			{"__typename": "Product", "sku": "chair-1"},
+			{"__typename": "Product", "upc": "404"},
+		}))
+		require.Len(t, resp.Entities, 3)
+		require.Equal(t, "Product", resp.Entities[0].Typename)
+		require.Equal(t, "Couch", resp.Entities[0].Name)
+		require.Equal(t, "Chair", resp.Entities[1].Name)
+		require.Equal(t, "3", resp.Entities[1].Upc)
+		require.Nil(t, resp.Entities[2])
+	})
+
+	t.Run("extended entities get their required fields from the representation", func(t *testing.T) {
+		var resp entities
+		c.MustPost(entitiesQuery, &resp, client.Var("representations", []map[string]interface{}{
+			{"__typename": "User", "id": "1", "username": "alice"},
+		}))
+		require.Len(t, resp.Entities, 1)
+		require.Equal(t, "User", resp.Entities[0].Typename)
+		require.Equal(t, "Hello alice", resp.Entities[0].Greeting)
+		require.Len(t, resp.Entities[0].Reviews, 2)
+		require.Equal(t, "Table", resp.Entities[0].Reviews[0].Product.Name)
+	})
+
+	t.Run("representations without a key", func(t *testing.T) {
+		var resp entities
+		err := c.Post(entitiesQuery, &resp, client.Var("representations", []map[string]interface{}{
+			{"__typename": "Product", "upc": "2"},
+			{"__typename": "Product", "name": "Table"},
+		}))
+		require.EqualError(t, err, `[{"message":"the representation of Product doesn't have the fields of any of its keys","path":["_entities",1]}]`)
+		require.Len(t, resp.Entities, 2)
+		require.Equal(t, "Couch", resp.Entities[0].Name)
+		require.Nil(t, resp.Entities[1])
+	})
+
+	t.Run("representations of types that aren't entities", func(t *testing.T) {
+		var resp entities
+		err := c.Post(entitiesQuery, &resp, client.Var("representations", []map[string]interface{}{
+			{"__typename": "Review", "body": "Love it!"},
+			{"__typename": "Product", "upc": "2"},
+		}))
+		require.EqualError(t, err, `[{"message":"Review is not an entity","path":["_entities",0]}]`)
+		require.Len(t, resp.Entities, 2)
+		require.Nil(t, resp.Entities[0])
+		require.Equal(t, "Couch", resp.Entities[1].Name)
+	})
+}
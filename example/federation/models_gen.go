@@ -0,0 +1,27 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package federation
+
+type Product struct {
+	Upc   string `json:"upc"`
+	Sku   string `json:"sku"`
+	Name  string `json:"name"`
+	Price int    `json:"price"`
+}
+
+func (Product) IsEntity() {}
+
+type Review struct {
+	Body    string  `json:"body"`
+	Author  User    `json:"author"`
+	Product Product `json:"product"`
+}
+
+type User struct {
+	ID       string   `json:"id"`
+	Username string   `json:"username"`
+	Greeting string   `json:"greeting"`
+	Reviews  []Review `json:"reviews"`
+}
+
+func (User) IsEntity() {}
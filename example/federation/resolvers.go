@@ -0,0 +1,72 @@
+//go:generate gorunpkg github.com/99designs/gqlgen
+
+package federation
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+var products = []Product{
+	{Upc: "1", Sku: "table-1", Name: "Table", Price: 899},
+	{Upc: "2", Sku: "couch-1", Name: "Couch", Price: 1299},
+	{Upc: "3", Sku: "chair-1", Name: "Chair", Price: 54},
+}
+
+// reviews by the id of the user that wrote them, users are owned by another service so only their ids are known here
+var reviews = map[string][]Review{
+	"1": {{Body: "Love it!", Product: products[0]}, {Body: "Too expensive.", Product: products[1]}},
+	"2": {{Body: "Could be better.", Product: products[2]}},
+}
+
+type Resolver struct{}
+
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+func (r *Resolver) Entity() EntityResolver {
+	return &entityResolver{r}
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) TopProducts(ctx context.Context, first *int) ([]Product, error) {
+	if first != nil && *first < len(products) {
+		return products[:*first], nil
+	}
+	return products, nil
+}
+
+type entityResolver struct{ *Resolver }
+
+func (r *entityResolver) FindProductBySku(ctx context.Context, sku string) (*Product, error) {
+	for i := range products {
+		if products[i].Sku == sku {
+			return &products[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *entityResolver) FindProductByUpc(ctx context.Context, upc string) (*Product, error) {
+	for i := range products {
+		if products[i].Upc == upc {
+			return &products[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *entityResolver) FindUserByID(ctx context.Context, id string) (*User, error) {
+	// username is owned by the accounts service, the gateway sends it because greeting @requires it
+	username, _ := graphql.GetEntityRepresentation(ctx)["username"].(string)
+
+	user := &User{ID: id, Username: username, Greeting: "Hello " + username, Reviews: []Review{}}
+	for _, review := range reviews[id] {
+		review.Author = *user
+		user.Reviews = append(user.Reviews, review)
+	}
+	return user, nil
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/99designs/gqlgen/example/federation"
+	"github.com/99designs/gqlgen/handler"
+)
+
+func main() {
+	http.Handle("/", handler.Playground("Federation", "/query"))
+	http.Handle("/query", handler.GraphQL(federation.NewExecutableSchema(federation.Config{Resolvers: &federation.Resolver{}})))
+
+	log.Println("connect to http://localhost:8088/ for graphql playground")
+	log.Fatal(http.ListenAndServe(":8088", nil))
+}
@@ -0,0 +1,53 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package relay
+
+type Node interface {
+	IsNode()
+	GetID() string
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type Post struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func (Post) IsNode()         {}
+func (p Post) GetID() string { return p.ID }
+
+type PostConnection struct {
+	Edges    []PostEdge `json:"edges"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+type PostEdge struct {
+	Cursor string `json:"cursor"`
+	Node   Post   `json:"node"`
+}
+
+type User struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Friends UserConnection `json:"friends"`
+	Posts   PostConnection `json:"posts"`
+}
+
+func (User) IsNode()         {}
+func (u User) GetID() string { return u.ID }
+
+type UserConnection struct {
+	Edges    []UserEdge `json:"edges"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+type UserEdge struct {
+	Cursor string `json:"cursor"`
+	Node   User   `json:"node"`
+}
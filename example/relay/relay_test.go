@@ -0,0 +1,150 @@
+package relay
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelay(t *testing.T) {
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(Config{Resolvers: NewResolver()})))
+	c := client.New(srv.URL)
+
+	type pageInfo struct {
+		HasNextPage     bool
+		HasPreviousPage bool
+		StartCursor     *string
+		EndCursor       *string
+	}
+	type users struct {
+		Users struct {
+			Edges []struct {
+				Cursor string
+				Node   struct{ Name string }
+			}
+			PageInfo pageInfo
+		}
+	}
+
+	t.Run("paginate forwards", func(t *testing.T) {
+		query := `query($after: String) { users(first: 2, after: $after) { edges { cursor node { name } } pageInfo { hasNextPage hasPreviousPage startCursor endCursor } } }`
+
+		var resp users
+		c.MustPost(query, &resp)
+		require.Len(t, resp.Users.Edges, 2)
+		require.Equal(t, "Alice", resp.Users.Edges[0].Node.Name)
+		require.Equal(t, "Bob", resp.Users.Edges[1].Node.Name)
+		require.True(t, resp.Users.PageInfo.HasNextPage)
+		require.Equal(t, resp.Users.Edges[1].Cursor, *resp.Users.PageInfo.EndCursor)
+
+		var next users
+		c.MustPost(query, &next, client.Var("after", *resp.Users.PageInfo.EndCursor))
+		require.Len(t, next.Users.Edges, 2)
+		require.Equal(t, "Carol", next.Users.Edges[0].Node.Name)
+		require.Equal(t, "Dave", next.Users.Edges[1].Node.Name)
+		require.True(t, next.Users.PageInfo.HasNextPage)
+	})
+
+	t.Run("paginate backwards", func(t *testing.T) {
+		var resp users
+		c.MustPost(`{ users(last: 2) { edges { cursor node { name } } pageInfo { hasNextPage hasPreviousPage startCursor endCursor } } }`, &resp)
+		require.Len(t, resp.Users.Edges, 2)
+		require.Equal(t, "Dave", resp.Users.Edges[0].Node.Name)
+		require.Equal(t, "Eve", resp.Users.Edges[1].Node.Name)
+		require.True(t, resp.Users.PageInfo.HasPreviousPage)
+		require.False(t, resp.Users.PageInfo.HasNextPage)
+	})
+
+	t.Run("empty connections have no cursors", func(t *testing.T) {
+		var resp struct {
+			Viewer struct {
+				Friends struct {
+					Edges []struct{ Cursor string }
+				}
+				Posts struct {
+					Edges    []struct{ Cursor string }
+					PageInfo pageInfo
+				}
+			}
+		}
+		c.MustPost(`{ viewer { friends(first: 0) { edges { cursor } } posts(first: 0) { edges { cursor } pageInfo { hasNextPage hasPreviousPage startCursor endCursor } } } }`, &resp)
+		require.Len(t, resp.Viewer.Friends.Edges, 0)
+		require.Len(t, resp.Viewer.Posts.Edges, 0)
+		require.True(t, resp.Viewer.Posts.PageInfo.HasNextPage)
+		require.Nil(t, resp.Viewer.Posts.PageInfo.StartCursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		var resp interface{}
+		err := c.Post(`{ users(after: "nope") { edges { cursor } } }`, &resp)
+		require.EqualError(t, err, `[{"message":"nope is not a valid cursor","path":["users"]}]`)
+	})
+
+	t.Run("refetch a node", func(t *testing.T) {
+		var resp struct {
+			Node struct {
+				Typename string `json:"__typename"`
+				ID       string
+				Name     string
+			}
+		}
+		id := graphql.EncodeGlobalID("User", "2")
+		c.MustPost(`query($id: ID!) { node(id: $id) { __typename id ... on User { name } } }`, &resp, client.Var("id", id))
+		require.Equal(t, "User", resp.Node.Typename)
+		require.Equal(t, id, resp.Node.ID)
+		require.Equal(t, "Bob", resp.Node.Name)
+	})
+
+	t.Run("refetch many nodes", func(t *testing.T) {
+		var resp struct {
+			Nodes []*struct {
+				Typename string `json:"__typename"`
+				Name     string
+				Title    string
+			}
+		}
+		c.MustPost(`query($ids: [ID!]!) { nodes(ids: $ids) { __typename ... on User { name } ... on Post { title } } }`, &resp, client.Var("ids", []string{
+			graphql.EncodeGlobalID("Post", "2"),
+			graphql.EncodeGlobalID("User", "404"),
+			graphql.EncodeGlobalID("User", "3"),
+		}))
+		require.Len(t, resp.Nodes, 3)
+		require.Equal(t, "Post", resp.Nodes[0].Typename)
+		require.Equal(t, "Paginating with connections", resp.Nodes[0].Title)
+		require.Nil(t, resp.Nodes[1])
+		require.Equal(t, "Carol", resp.Nodes[2].Name)
+	})
+
+	t.Run("ids that can't be resolved are null in the list", func(t *testing.T) {
+		var resp struct {
+			Nodes []*struct{ Name string }
+			Other *struct{ Name string } `json:"other"`
+		}
+		err := c.Post(`query($ids: [ID!]!, $id: ID!) {
+			nodes(ids: $ids) { ... on User { name } }
+			other: node(id: $id) { ... on User { name } }
+		}`, &resp, client.Var("ids", []string{
+			"not a global id",
+			graphql.EncodeGlobalID("Comment", "1"),
+			graphql.EncodeGlobalID("User", "3"),
+		}), client.Var("id", graphql.EncodeGlobalID("User", "3")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `"path":["nodes",0]`)
+		require.Contains(t, err.Error(), `{"message":"Comment does not implement Node","path":["nodes",1]}`)
+		require.Len(t, resp.Nodes, 3)
+		require.Nil(t, resp.Nodes[0])
+		require.Nil(t, resp.Nodes[1])
+		require.Equal(t, "Carol", resp.Nodes[2].Name)
+		require.Equal(t, "Carol", resp.Other.Name)
+	})
+
+	t.Run("unknown node type", func(t *testing.T) {
+		var resp interface{}
+		err := c.Post(`query($id: ID!) { node(id: $id) { id } }`, &resp, client.Var("id", graphql.EncodeGlobalID("Comment", "1")))
+		require.EqualError(t, err, `[{"message":"Comment does not implement Node","path":["node"]}]`)
+	})
+}
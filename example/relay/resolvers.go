@@ -0,0 +1,133 @@
+//go:generate gorunpkg github.com/99designs/gqlgen
+
+package relay
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type Resolver struct {
+	users   []User
+	friends map[string][]string // user id to the ids of their friends
+	posts   map[string][]Post   // user id to the posts they wrote
+}
+
+// NewResolver returns a resolver with a few users that know each other, the ids are global ids so they can be
+// passed straight back to node
+func NewResolver() *Resolver {
+	r := &Resolver{
+		friends: map[string][]string{},
+		posts:   map[string][]Post{},
+	}
+	for i, name := range []string{"Alice", "Bob", "Carol", "Dave", "Eve"} {
+		r.users = append(r.users, User{ID: graphql.EncodeGlobalID("User", strconv.Itoa(i+1)), Name: name})
+	}
+	for i, user := range r.users {
+		for j, friend := range r.users {
+			if i != j {
+				r.friends[user.ID] = append(r.friends[user.ID], friend.ID)
+			}
+		}
+	}
+	r.posts[r.users[0].ID] = []Post{
+		{ID: graphql.EncodeGlobalID("Post", "1"), Title: "Hello world"},
+		{ID: graphql.EncodeGlobalID("Post", "2"), Title: "Paginating with connections"},
+	}
+	return r
+}
+
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+func (r *Resolver) User() UserResolver {
+	return &userResolver{r}
+}
+
+func (r *Resolver) Node() NodeResolver {
+	return &nodeResolver{r}
+}
+
+func (r *Resolver) user(id string) *User {
+	for i := range r.users {
+		if r.users[i].ID == id {
+			return &r.users[i]
+		}
+	}
+	return nil
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) Viewer(ctx context.Context) (User, error) {
+	return r.users[0], nil
+}
+
+func (r *queryResolver) Users(ctx context.Context, first *int, after *string, last *int, before *string) (UserConnection, error) {
+	return userConnection(r.users, first, after, last, before)
+}
+
+type userResolver struct{ *Resolver }
+
+func (r *userResolver) Friends(ctx context.Context, obj *User, first *int, after *string, last *int, before *string) (UserConnection, error) {
+	var friends []User
+	for _, id := range r.Resolver.friends[obj.ID] {
+		friends = append(friends, *r.user(id))
+	}
+	return userConnection(friends, first, after, last, before)
+}
+
+func (r *userResolver) Posts(ctx context.Context, obj *User, first *int, after *string, last *int, before *string) (PostConnection, error) {
+	posts := r.Resolver.posts[obj.ID]
+	page, err := graphql.Paginate(len(posts), first, after, last, before)
+	if err != nil {
+		return PostConnection{}, err
+	}
+	conn := PostConnection{Edges: []PostEdge{}, PageInfo: pageInfo(page)}
+	for i, post := range posts[page.Start:page.End] {
+		conn.Edges = append(conn.Edges, PostEdge{Cursor: page.Cursor(i), Node: post})
+	}
+	return conn, nil
+}
+
+type nodeResolver struct{ *Resolver }
+
+func (r *nodeResolver) Post(ctx context.Context, id string) (*Post, error) {
+	globalID := graphql.EncodeGlobalID("Post", id)
+	for _, posts := range r.posts {
+		for i := range posts {
+			if posts[i].ID == globalID {
+				return &posts[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (r *nodeResolver) User(ctx context.Context, id string) (*User, error) {
+	return r.user(graphql.EncodeGlobalID("User", id)), nil
+}
+
+func userConnection(users []User, first *int, after *string, last *int, before *string) (UserConnection, error) {
+	page, err := graphql.Paginate(len(users), first, after, last, before)
+	if err != nil {
+		return UserConnection{}, err
+	}
+	conn := UserConnection{Edges: []UserEdge{}, PageInfo: pageInfo(page)}
+	for i, user := range users[page.Start:page.End] {
+		conn.Edges = append(conn.Edges, UserEdge{Cursor: page.Cursor(i), Node: user})
+	}
+	return conn, nil
+}
+
+func pageInfo(page graphql.Page) PageInfo {
+	return PageInfo{
+		HasPreviousPage: page.HasPreviousPage,
+		HasNextPage:     page.HasNextPage,
+		StartCursor:     page.StartCursor(),
+		EndCursor:       page.EndCursor(),
+	}
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/99designs/gqlgen/example/relay"
+	"github.com/99designs/gqlgen/handler"
+)
+
+func main() {
+	http.Handle("/", handler.Playground("Relay", "/query"))
+	http.Handle("/query", handler.GraphQL(relay.NewExecutableSchema(relay.Config{Resolvers: relay.NewResolver()})))
+
+	log.Println("connect to http://localhost:8087/ for graphql playground")
+	log.Fatal(http.ListenAndServe(":8087", nil))
+}
@@ -21,9 +21,10 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
-		resolvers:  cfg.Resolvers,
-		directives: cfg.Directives,
-		complexity: cfg.Complexity,
+		resolvers:        cfg.Resolvers,
+		directives:       cfg.Directives,
+		complexity:       cfg.Complexity,
+		concurrencyLimit: cfg.ConcurrencyLimit,
 	}
 }
 
@@ -31,6 +32,9 @@ type Config struct {
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
+	// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,
+	// it is used when the request context doesn't set its own limit.
+	ConcurrencyLimit int
 }
 
 type ResolverRoot interface {
@@ -154,9 +158,10 @@ func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]in
 }
 
 type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
+	resolvers        ResolverRoot
+	directives       DirectiveRoot
+	complexity       ComplexityRoot
+	concurrencyLimit int
 }
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -265,7 +270,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._Query(ctx, op.SelectionSet)
@@ -293,6 +298,14 @@ type executionContext struct {
 	*executableSchema
 }
 
+func (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	if ec.ConcurrencyLimit == 0 {
+		ec.ConcurrencyLimit = e.concurrencyLimit
+	}
+	return ec
+}
+
 var addressImplementors = []string{"Address"}
 
 // nolint: gocyclo, errcheck, gas, goconst
@@ -400,20 +413,22 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Query")
 		case "user":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_user(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "search":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._Query_search(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -433,7 +448,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_user_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -448,7 +463,9 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().User(rctx, args["id"].(external.ObjectID))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().User(rctx, args["id"].(external.ObjectID))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -468,7 +485,7 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 func (ec *executionContext) _Query_search(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query_search_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -483,7 +500,9 @@ func (ec *executionContext) _Query_search(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Search(rctx, args["input"].(*model.SearchArgs))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.Query().Search(rctx, args["input"].(*model.SearchArgs))
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -522,7 +541,7 @@ func (ec *executionContext) _Query_search(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -534,7 +553,7 @@ func (ec *executionContext) _Query_search(ctx context.Context, field graphql.Col
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_Query___type_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -627,23 +646,25 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 				invalid = true
 			}
 		case "primitiveResolver":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._User_primitiveResolver(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "customResolver":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._User_customResolver(ctx, field, obj)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "address":
 			out.Values[i] = ec._User_address(ctx, field, obj)
 		case "tier":
@@ -777,7 +798,9 @@ func (ec *executionContext) _User_primitiveResolver(ctx context.Context, field g
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().PrimitiveResolver(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.User().PrimitiveResolver(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -804,7 +827,9 @@ func (ec *executionContext) _User_customResolver(ctx context.Context, field grap
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().CustomResolver(rctx, obj)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.User().CustomResolver(rctx, obj)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -1048,7 +1073,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1353,7 +1378,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1700,7 +1725,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1853,7 +1878,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1990,7 +2015,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_fields_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2041,7 +2066,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2098,7 +2123,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2155,7 +2180,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2167,7 +2192,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_enumValues_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2218,7 +2243,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2275,7 +2300,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2362,6 +2387,37 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 			ret = nil
 		}
 	}()
+	rctx := graphql.GetResolverContext(ctx)
+	timeout := ec.ResolverTimeout
+	for _, d := range rctx.Field.Definition.Directives {
+		if d.Name == "timeout" {
+			ms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)["ms"])
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
+			}
+			timeout = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives in the query run outside of those in the schema
+	for _, d := range rctx.Field.QueryDirectives() {
+		var err error
+		next, err = ec.directive(obj, d, d.Location, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	if timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {
+		ctx = graphql.WithFieldTimeout(ctx, timeout)
+	}
 	res, err := ec.ResolverMiddleware(ctx, next)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2370,6 +2426,28 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 	return res
 }
 
+// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one
+func (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {
+	switch d.Name {
+	}
+	return next, nil
+}
+
+// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value
+func (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {
+	next := func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+	for _, d := range directives {
+		var err error
+		next, err = ec.directive(obj, d, location, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
+
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
@@ -8,6 +8,8 @@ import (
 
 type Event interface {
 	IsEvent()
+	GetSelection() []string
+	GetCollected() []string
 }
 
 type Like struct {
@@ -17,7 +19,9 @@ type Like struct {
 	Collected []string  `json:"collected"`
 }
 
-func (Like) IsEvent() {}
+func (Like) IsEvent()                 {}
+func (l Like) GetSelection() []string { return l.Selection }
+func (l Like) GetCollected() []string { return l.Collected }
 
 type Post struct {
 	Message   string    `json:"message"`
@@ -26,4 +30,6 @@ type Post struct {
 	Collected []string  `json:"collected"`
 }
 
-func (Post) IsEvent() {}
+func (Post) IsEvent()                 {}
+func (p Post) GetSelection() []string { return p.Selection }
+func (p Post) GetCollected() []string { return p.Collected }
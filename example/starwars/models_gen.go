@@ -3,6 +3,8 @@
 package starwars
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
@@ -44,6 +46,12 @@ const (
 	EpisodeJedi    Episode = "JEDI"
 )
 
+var AllEpisode = []Episode{
+	EpisodeNewhope,
+	EpisodeEmpire,
+	EpisodeJedi,
+}
+
 func (e Episode) IsValid() bool {
 	switch e {
 	case EpisodeNewhope, EpisodeEmpire, EpisodeJedi:
@@ -64,7 +72,7 @@ func (e *Episode) UnmarshalGQL(v interface{}) error {
 
 	*e = Episode(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid Episode", str)
+		return fmt.Errorf("%s is not a valid Episode, expected one of NEWHOPE, EMPIRE, JEDI", str)
 	}
 	return nil
 }
@@ -73,6 +81,23 @@ func (e Episode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+func (e *Episode) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("Episode must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e Episode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type LengthUnit string
 
 const (
@@ -80,6 +105,11 @@ const (
 	LengthUnitFoot  LengthUnit = "FOOT"
 )
 
+var AllLengthUnit = []LengthUnit{
+	LengthUnitMeter,
+	LengthUnitFoot,
+}
+
 func (e LengthUnit) IsValid() bool {
 	switch e {
 	case LengthUnitMeter, LengthUnitFoot:
@@ -100,7 +130,7 @@ func (e *LengthUnit) UnmarshalGQL(v interface{}) error {
 
 	*e = LengthUnit(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid LengthUnit", str)
+		return fmt.Errorf("%s is not a valid LengthUnit, expected one of METER, FOOT", str)
 	}
 	return nil
 }
@@ -108,3 +138,20 @@ func (e *LengthUnit) UnmarshalGQL(v interface{}) error {
 func (e LengthUnit) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+func (e *LengthUnit) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("LengthUnit must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e LengthUnit) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
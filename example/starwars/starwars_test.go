@@ -1,6 +1,7 @@
 package starwars
 
 import (
+	"encoding/json"
 	"net/http/httptest"
 	"testing"
 
@@ -215,7 +216,7 @@ func TestStarwars(t *testing.T) {
 		  }
 		}`, &resp, client.Var("episode", "INVALID"))
 
-		require.EqualError(t, err, `[{"message":"INVALID is not a valid Episode"}]`)
+		require.EqualError(t, err, `[{"message":"INVALID is not a valid Episode, expected one of NEWHOPE, EMPIRE, JEDI"}]`)
 	})
 
 	t.Run("introspection", func(t *testing.T) {
@@ -240,3 +241,30 @@ func TestStarwars(t *testing.T) {
 		require.Equal(t, resp.Character, resp.AliasedCharacter)
 	})
 }
+
+func TestEpisode(t *testing.T) {
+	require.Equal(t, []Episode{EpisodeNewhope, EpisodeEmpire, EpisodeJedi}, AllEpisode)
+
+	b, err := json.Marshal(map[string]Episode{"episode": EpisodeJedi})
+	require.NoError(t, err)
+	require.Equal(t, `{"episode":"JEDI"}`, string(b))
+
+	var e Episode
+	require.NoError(t, json.Unmarshal([]byte(`"EMPIRE"`), &e))
+	require.Equal(t, EpisodeEmpire, e)
+
+	require.NoError(t, json.Unmarshal([]byte(`"\u004aEDI"`), &e))
+	require.Equal(t, EpisodeJedi, e)
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), &e))
+	require.Equal(t, EpisodeJedi, e)
+
+	err = json.Unmarshal([]byte(`"INVALID"`), &e)
+	require.EqualError(t, err, "INVALID is not a valid Episode, expected one of NEWHOPE, EMPIRE, JEDI")
+
+	err = json.Unmarshal([]byte(`"\/"`), &e)
+	require.EqualError(t, err, "/ is not a valid Episode, expected one of NEWHOPE, EMPIRE, JEDI")
+
+	err = json.Unmarshal([]byte(`1`), &e)
+	require.EqualError(t, err, "Episode must be a json string")
+}
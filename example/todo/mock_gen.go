@@ -0,0 +1,115 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package todo
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MockResolver returns fake data for every field, so the schema can be served before the real resolvers exist.
+// The same Seed always returns the same data for a query.
+type MockResolver struct {
+	Seed int64
+}
+
+var _ ResolverRoot = &MockResolver{}
+
+// mockDepth is how deep mocked structs are filled in, so that types that refer to themselves don't recurse forever
+const mockDepth = 3
+
+func (r *MockResolver) MyMutation() MyMutationResolver {
+	return &mockMyMutationResolver{r}
+}
+func (r *MockResolver) MyQuery() MyQueryResolver {
+	return &mockMyQueryResolver{r}
+}
+
+type mockMyMutationResolver struct{ *MockResolver }
+
+func (r *mockMyMutationResolver) CreateTodo(ctx context.Context, todo TodoInput) (res Todo, err error) {
+	rnd := graphql.MockRand(ctx, r.Seed)
+	if res, err = r.mockTodo(rnd, 1); err != nil {
+		return
+	}
+	return
+}
+
+func (r *mockMyMutationResolver) UpdateTodo(ctx context.Context, id int, changes map[string]interface{}) (res *Todo, err error) {
+	rnd := graphql.MockRand(ctx, r.Seed)
+	{
+		var ptr1 Todo
+		if ptr1, err = r.mockTodo(rnd, 1); err != nil {
+			return
+		}
+		res = &ptr1
+	}
+	return
+}
+
+type mockMyQueryResolver struct{ *MockResolver }
+
+func (r *mockMyQueryResolver) Todo(ctx context.Context, id int) (res *Todo, err error) {
+	rnd := graphql.MockRand(ctx, r.Seed)
+	{
+		var ptr1 Todo
+		if ptr1, err = r.mockTodo(rnd, 1); err != nil {
+			return
+		}
+		res = &ptr1
+	}
+	return
+}
+
+func (r *mockMyQueryResolver) LastTodo(ctx context.Context) (res *Todo, err error) {
+	rnd := graphql.MockRand(ctx, r.Seed)
+	{
+		var ptr1 Todo
+		if ptr1, err = r.mockTodo(rnd, 1); err != nil {
+			return
+		}
+		res = &ptr1
+	}
+	return
+}
+
+func (r *mockMyQueryResolver) Todos(ctx context.Context) (res []Todo, err error) {
+	rnd := graphql.MockRand(ctx, r.Seed)
+	res = make([]Todo, 2)
+	for idx1 := range res {
+		if res[idx1], err = r.mockTodo(rnd, 1); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (r *MockResolver) mockTodo(rnd *rand.Rand, depth int) (res Todo, err error) {
+	if depth > mockDepth {
+		return
+	}
+	{
+		raw := graphql.MockScalar(rnd, "Int")
+		res.ID, err = graphql.UnmarshalInt(raw)
+		if err != nil {
+			return
+		}
+	}
+	{
+		raw := graphql.MockScalar(rnd, "String")
+		res.Text, err = graphql.UnmarshalString(raw)
+		if err != nil {
+			return
+		}
+	}
+	{
+		raw := graphql.MockScalar(rnd, "Boolean")
+		res.Done, err = graphql.UnmarshalBoolean(raw)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -3,6 +3,8 @@
 package todo
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
@@ -21,6 +23,11 @@ const (
 	RoleOwner Role = "OWNER"
 )
 
+var AllRole = []Role{
+	RoleAdmin,
+	RoleOwner,
+}
+
 func (e Role) IsValid() bool {
 	switch e {
 	case RoleAdmin, RoleOwner:
@@ -41,7 +48,7 @@ func (e *Role) UnmarshalGQL(v interface{}) error {
 
 	*e = Role(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid Role", str)
+		return fmt.Errorf("%s is not a valid Role, expected one of ADMIN, OWNER", str)
 	}
 	return nil
 }
@@ -49,3 +56,20 @@ func (e *Role) UnmarshalGQL(v interface{}) error {
 func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("Role must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -1,10 +1,12 @@
 package todo
 
 import (
+	"context"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/example/todo/todoclient"
 	"github.com/99designs/gqlgen/graphql/introspection"
 	"github.com/99designs/gqlgen/handler"
 	"github.com/stretchr/testify/require"
@@ -243,3 +245,65 @@ func TestSkipAndIncludeDirectives(t *testing.T) {
 		require.False(t, ok)
 	})
 }
+
+func TestMockResolver(t *testing.T) {
+	mock := func(seed int64) *client.Client {
+		config := New()
+		config.Resolvers = &MockResolver{Seed: seed}
+		return client.New(httptest.NewServer(handler.GraphQL(NewExecutableSchema(config))).URL)
+	}
+	type response struct {
+		Todos []struct {
+			ID   int
+			Text string
+		}
+		LastTodo *struct{ ID int }
+	}
+	query := `{ todos { id text } lastTodo { id } }`
+
+	var first, second, other response
+	mock(1).MustPost(query, &first)
+	mock(1).MustPost(query, &second)
+	mock(2).MustPost(query, &other)
+
+	require.Len(t, first.Todos, 2)
+	require.NotEmpty(t, first.Todos[0].Text)
+	require.NotNil(t, first.LastTodo)
+	require.Equal(t, first, second)
+	require.NotEqual(t, first, other)
+}
+
+func TestTypedClient(t *testing.T) {
+	srv := httptest.NewServer(handler.GraphQL(NewExecutableSchema(New())))
+	c := todoclient.NewClient(&client.HTTPTransport{URL: srv.URL})
+	ctx := context.Background()
+
+	created, err := c.CreateTodo(ctx, todoclient.TodoInput{Text: "Write the client"})
+	require.NoError(t, err)
+	require.Equal(t, 5, created.CreateTodo.ID)
+
+	updated, err := c.UpdateTodo(ctx, created.CreateTodo.ID, map[string]interface{}{"text": "Write the typed client"})
+	require.NoError(t, err)
+	require.Equal(t, "Write the typed client", updated.UpdateTodo.Text)
+
+	todo, err := c.Todo(ctx, created.CreateTodo.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Write the typed client", todo.Todo.Text)
+
+	todos, err := c.Todos(ctx)
+	require.NoError(t, err)
+	require.Len(t, todos.Todos, 5)
+
+	t.Run("errors are returned with the partial response", func(t *testing.T) {
+		todo, err := c.Todo(ctx, 99)
+		require.EqualError(t, err, "todo: not found")
+		require.IsType(t, client.Errors{}, err)
+		require.Nil(t, todo.Todo)
+	})
+
+	t.Run("invalid requests are rejected", func(t *testing.T) {
+		c := client.HTTPTransport{URL: srv.URL}
+		err := c.Do(ctx, &client.Request{Query: "{ nope }"}, &struct{}{})
+		require.EqualError(t, err, `Cannot query field "nope" on type "MyQuery".`)
+	})
+}
@@ -0,0 +1,134 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package todoclient
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/client"
+)
+
+// Client sends the operations it was generated for, so that the variables and responses are checked
+// against the schema at compile time
+type Client struct {
+	Transport client.Transport
+}
+
+// NewClient creates a client that sends its requests with the transport, eg
+// &client.HTTPTransport{URL: "http://localhost:8080/query"}
+func NewClient(transport client.Transport) *Client {
+	return &Client{Transport: transport}
+}
+
+const TodosDocument = `query Todos {
+    todos { ...TodoFields }
+}
+
+fragment TodoFields on Todo {
+    id
+    text
+}`
+
+// Todos sends the Todos query. Any errors are returned along with the partial response.
+func (c *Client) Todos(ctx context.Context) (*TodosResponse, error) {
+	vars := map[string]interface{}{}
+
+	var resp TodosResponse
+	err := c.Transport.Do(ctx, &client.Request{Query: TodosDocument, OperationName: "Todos", Variables: vars}, &resp)
+	return &resp, err
+}
+
+const TodoDocument = `query Todo($id: Int!) {
+    todo(id: $id) { ...TodoFields }
+}
+
+fragment TodoFields on Todo {
+    id
+    text
+}`
+
+// Todo sends the Todo query. Any errors are returned along with the partial response.
+func (c *Client) Todo(ctx context.Context, id int) (*TodoResponse, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var resp TodoResponse
+	err := c.Transport.Do(ctx, &client.Request{Query: TodoDocument, OperationName: "Todo", Variables: vars}, &resp)
+	return &resp, err
+}
+
+const CreateTodoDocument = `mutation CreateTodo($todo: TodoInput!) {
+    createTodo(todo: $todo) { id text }
+}`
+
+// CreateTodo sends the CreateTodo mutation. Any errors are returned along with the partial response.
+func (c *Client) CreateTodo(ctx context.Context, todo TodoInput) (*CreateTodoResponse, error) {
+	vars := map[string]interface{}{
+		"todo": todo,
+	}
+
+	var resp CreateTodoResponse
+	err := c.Transport.Do(ctx, &client.Request{Query: CreateTodoDocument, OperationName: "CreateTodo", Variables: vars}, &resp)
+	return &resp, err
+}
+
+const UpdateTodoDocument = `mutation UpdateTodo($id: Int!, $changes: Map!) {
+    updateTodo(id: $id, changes: $changes) { id text }
+}`
+
+// UpdateTodo sends the UpdateTodo mutation. Any errors are returned along with the partial response.
+func (c *Client) UpdateTodo(ctx context.Context, id int, changes map[string]interface{}) (*UpdateTodoResponse, error) {
+	vars := map[string]interface{}{
+		"id":      id,
+		"changes": changes,
+	}
+
+	var resp UpdateTodoResponse
+	err := c.Transport.Do(ctx, &client.Request{Query: UpdateTodoDocument, OperationName: "UpdateTodo", Variables: vars}, &resp)
+	return &resp, err
+}
+
+type TodosResponse struct {
+	Todos []TodosResponseTodos `json:"todos"`
+}
+
+type TodosResponseTodos struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+type TodoResponse struct {
+	Todo *TodoResponseTodo `json:"todo"`
+}
+
+type TodoResponseTodo struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+type CreateTodoResponse struct {
+	CreateTodo CreateTodoResponseCreateTodo `json:"createTodo"`
+}
+
+type CreateTodoResponseCreateTodo struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+type UpdateTodoResponse struct {
+	UpdateTodo *UpdateTodoResponseUpdateTodo `json:"updateTodo"`
+}
+
+type UpdateTodoResponseUpdateTodo struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+// Passed to createTodo to create a new todo
+type TodoInput struct {
+	// The body text
+	Text string `json:"text"`
+	// Is it done already?
+	Done *bool `json:"done,omitempty"`
+}
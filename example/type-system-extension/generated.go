@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -19,9 +20,10 @@ import (
 // NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
 func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
 	return &executableSchema{
-		resolvers:  cfg.Resolvers,
-		directives: cfg.Directives,
-		complexity: cfg.Complexity,
+		resolvers:        cfg.Resolvers,
+		directives:       cfg.Directives,
+		complexity:       cfg.Complexity,
+		concurrencyLimit: cfg.ConcurrencyLimit,
 	}
 }
 
@@ -29,6 +31,9 @@ type Config struct {
 	Resolvers  ResolverRoot
 	Directives DirectiveRoot
 	Complexity ComplexityRoot
+	// ConcurrencyLimit is the default number of goroutines each request may use to resolve fields,
+	// it is used when the request context doesn't set its own limit.
+	ConcurrencyLimit int
 }
 
 type ResolverRoot interface {
@@ -154,9 +159,10 @@ func field___Type_enumValues_args(rawArgs map[string]interface{}) (map[string]in
 }
 
 type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
+	resolvers        ResolverRoot
+	directives       DirectiveRoot
+	complexity       ComplexityRoot
+	concurrencyLimit int
 }
 
 func (e *executableSchema) Schema() *ast.Schema {
@@ -230,7 +236,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._MyQuery(ctx, op.SelectionSet)
@@ -246,7 +252,7 @@ func (e *executableSchema) Query(ctx context.Context, op *ast.OperationDefinitio
 }
 
 func (e *executableSchema) Mutation(ctx context.Context, op *ast.OperationDefinition) *graphql.Response {
-	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	ec := e.newExecutionContext(ctx)
 
 	buf := ec.RequestMiddleware(ctx, func(ctx context.Context) []byte {
 		data := ec._MyMutation(ctx, op.SelectionSet)
@@ -271,6 +277,14 @@ type executionContext struct {
 	*executableSchema
 }
 
+func (e *executableSchema) newExecutionContext(ctx context.Context) executionContext {
+	ec := executionContext{graphql.GetRequestContext(ctx), e}
+	if ec.ConcurrencyLimit == 0 {
+		ec.ConcurrencyLimit = e.concurrencyLimit
+	}
+	return ec
+}
+
 var myMutationImplementors = []string{"MyMutation"}
 
 // nolint: gocyclo, errcheck, gas, goconst
@@ -309,7 +323,7 @@ func (ec *executionContext) _MyMutation(ctx context.Context, sel ast.SelectionSe
 func (ec *executionContext) _MyMutation_createTodo(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_MyMutation_createTodo_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -324,7 +338,9 @@ func (ec *executionContext) _MyMutation_createTodo(ctx context.Context, field gr
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MyMutation().CreateTodo(rctx, args["todo"].(TodoInput))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.MyMutation().CreateTodo(rctx, args["todo"].(TodoInput))
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -359,20 +375,22 @@ func (ec *executionContext) _MyQuery(ctx context.Context, sel ast.SelectionSet)
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("MyQuery")
 		case "todos":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._MyQuery_todos(ctx, field)
 				if out.Values[i] == graphql.Null {
 					invalid = true
 				}
 				wg.Done()
-			}(i, field)
+			})
 		case "todo":
+			i, field := i, field
 			wg.Add(1)
-			go func(i int, field graphql.CollectedField) {
+			ec.Go(func() {
 				out.Values[i] = ec._MyQuery_todo(ctx, field)
 				wg.Done()
-			}(i, field)
+			})
 		case "__type":
 			out.Values[i] = ec._MyQuery___type(ctx, field)
 		case "__schema":
@@ -401,7 +419,9 @@ func (ec *executionContext) _MyQuery_todos(ctx context.Context, field graphql.Co
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MyQuery().Todos(rctx)
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.MyQuery().Todos(rctx)
+		})
 	})
 	if resTmp == nil {
 		if !ec.HasError(rctx) {
@@ -440,7 +460,7 @@ func (ec *executionContext) _MyQuery_todos(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -452,7 +472,7 @@ func (ec *executionContext) _MyQuery_todos(ctx context.Context, field graphql.Co
 func (ec *executionContext) _MyQuery_todo(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_MyQuery_todo_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -467,7 +487,9 @@ func (ec *executionContext) _MyQuery_todo(ctx context.Context, field graphql.Col
 	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
 	resTmp := ec.FieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.MyQuery().Todo(rctx, args["id"].(string))
+		return graphql.ResolveWithTimeout(rctx, func(rctx context.Context) (interface{}, error) {
+			return ec.resolvers.MyQuery().Todo(rctx, args["id"].(string))
+		})
 	})
 	if resTmp == nil {
 		return graphql.Null
@@ -487,7 +509,7 @@ func (ec *executionContext) _MyQuery_todo(ctx context.Context, field graphql.Col
 func (ec *executionContext) _MyQuery___type(ctx context.Context, field graphql.CollectedField) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field_MyQuery___type_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -881,7 +903,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1186,7 +1208,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1533,7 +1555,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1686,7 +1708,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1823,7 +1845,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_fields_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1874,7 +1896,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1931,7 +1953,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -1988,7 +2010,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2000,7 +2022,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) graphql.Marshaler {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() { ec.Tracer.EndFieldExecution(ctx) }()
-	rawArgs := field.ArgumentMap(ec.Variables)
+	rawArgs := graphql.ArgumentMap(field.Field, ec.Variables)
 	args, err := field___Type_enumValues_args(rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2051,7 +2073,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2108,7 +2130,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		if isLen1 {
 			f(idx1)
 		} else {
-			go f(idx1)
+			ec.Go(func() { f(idx1) })
 		}
 
 	}
@@ -2197,59 +2219,45 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 		}
 	}()
 	rctx := graphql.GetResolverContext(ctx)
+	timeout := ec.ResolverTimeout
 	for _, d := range rctx.Field.Definition.Directives {
-		switch d.Name {
-		case "enumLogging":
-			if ec.directives.EnumLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.EnumLogging(ctx, obj, n)
-				}
-			}
-		case "fieldLogging":
-			if ec.directives.FieldLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.FieldLogging(ctx, obj, n)
-				}
-			}
-		case "inputLogging":
-			if ec.directives.InputLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.InputLogging(ctx, obj, n)
-				}
-			}
-		case "interfaceLogging":
-			if ec.directives.InterfaceLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.InterfaceLogging(ctx, obj, n)
-				}
-			}
-		case "objectLogging":
-			if ec.directives.ObjectLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.ObjectLogging(ctx, obj, n)
-				}
-			}
-		case "scalarLogging":
-			if ec.directives.ScalarLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.ScalarLogging(ctx, obj, n)
-				}
-			}
-		case "unionLogging":
-			if ec.directives.UnionLogging != nil {
-				n := next
-				next = func(ctx context.Context) (interface{}, error) {
-					return ec.directives.UnionLogging(ctx, obj, n)
-				}
+		if d.Name == "timeout" {
+			ms, err := graphql.UnmarshalInt(d.ArgumentMap(ec.Variables)["ms"])
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
 			}
+			timeout = time.Duration(ms) * time.Millisecond
+			continue
+		}
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationFieldDefinition, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	// directives on enum values used in the arguments and on the object run outside of those on the field
+	for _, d := range parsedSchema.Types[rctx.Object].Directives {
+		var err error
+		next, err = ec.directive(obj, d, ast.LocationObject, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
 		}
 	}
+	// directives in the query run outside of those in the schema
+	for _, d := range rctx.Field.QueryDirectives() {
+		var err error
+		next, err = ec.directive(obj, d, d.Location, next)
+		if err != nil {
+			ec.Error(ctx, err)
+			return nil
+		}
+	}
+	if timeout > 0 || graphql.GetFieldTimeout(ctx) > 0 {
+		ctx = graphql.WithFieldTimeout(ctx, timeout)
+	}
 	res, err := ec.ResolverMiddleware(ctx, next)
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2258,6 +2266,84 @@ func (ec *executionContext) FieldMiddleware(ctx context.Context, obj interface{}
 	return res
 }
 
+// directive wraps next in the DirectiveRoot handler for a directive, next is returned as is when there isn't one
+func (ec *executionContext) directive(obj interface{}, d *ast.Directive, location ast.DirectiveLocation, next graphql.Resolver) (graphql.Resolver, error) {
+	switch d.Name {
+	case "enumLogging":
+		if ec.directives.EnumLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.EnumLogging(ctx, obj, n)
+			}, nil
+		}
+	case "fieldLogging":
+		if ec.directives.FieldLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.FieldLogging(ctx, obj, n)
+			}, nil
+		}
+	case "inputLogging":
+		if ec.directives.InputLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.InputLogging(ctx, obj, n)
+			}, nil
+		}
+	case "interfaceLogging":
+		if ec.directives.InterfaceLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.InterfaceLogging(ctx, obj, n)
+			}, nil
+		}
+	case "objectLogging":
+		if ec.directives.ObjectLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.ObjectLogging(ctx, obj, n)
+			}, nil
+		}
+	case "scalarLogging":
+		if ec.directives.ScalarLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.ScalarLogging(ctx, obj, n)
+			}, nil
+		}
+	case "unionLogging":
+		if ec.directives.UnionLogging != nil {
+			n := next
+			return func(ctx context.Context) (interface{}, error) {
+				ctx = graphql.WithDirectiveContext(ctx, &graphql.DirectiveContext{Directive: d, Location: location})
+				return ec.directives.UnionLogging(ctx, obj, n)
+			}, nil
+		}
+	}
+	return next, nil
+}
+
+// directiveValue runs directives on an unmarshaled argument or input field, the result replaces the value
+func (ec *executionContext) directiveValue(ctx context.Context, obj interface{}, directives ast.DirectiveList, location ast.DirectiveLocation, value interface{}) (interface{}, error) {
+	next := func(ctx context.Context) (interface{}, error) {
+		return value, nil
+	}
+	for _, d := range directives {
+		var err error
+		next, err = ec.directive(obj, d, location, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return next(ctx)
+}
+
 func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
 	if ec.DisableIntrospection {
 		return nil, errors.New("introspection disabled")
@@ -3,6 +3,8 @@
 package type_system_extension
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
@@ -14,6 +16,7 @@ type Data interface {
 
 type Node interface {
 	IsNode()
+	GetID() string
 }
 
 type Todo struct {
@@ -23,8 +26,9 @@ type Todo struct {
 	Verified bool   `json:"verified"`
 }
 
-func (Todo) IsNode() {}
-func (Todo) IsData() {}
+func (Todo) IsNode()         {}
+func (Todo) IsData()         {}
+func (t Todo) GetID() string { return t.ID }
 
 type TodoInput struct {
 	Text string `json:"text"`
@@ -37,6 +41,11 @@ const (
 	StateDone   State = "DONE"
 )
 
+var AllState = []State{
+	StateNotYet,
+	StateDone,
+}
+
 func (e State) IsValid() bool {
 	switch e {
 	case StateNotYet, StateDone:
@@ -57,7 +66,7 @@ func (e *State) UnmarshalGQL(v interface{}) error {
 
 	*e = State(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid State", str)
+		return fmt.Errorf("%s is not a valid State, expected one of NOT_YET, DONE", str)
 	}
 	return nil
 }
@@ -65,3 +74,20 @@ func (e *State) UnmarshalGQL(v interface{}) error {
 func (e State) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+func (e *State) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("State must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e State) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,240 @@
+package graphql
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ConstraintFormats are the values accepted by the format argument of @constraint
+var ConstraintFormats = []string{"email"}
+
+// Constraint is a parsed @constraint directive from an argument or input field. The generated code checks it
+// once the value has been unmarshaled.
+type Constraint struct {
+	MinLength *int
+	MaxLength *int
+	Min       *float64
+	Max       *float64
+	Pattern   *regexp.Regexp
+	Format    string
+}
+
+// ParseConstraint builds a Constraint from the arguments of a @constraint directive
+func ParseConstraint(args map[string]interface{}) (*Constraint, error) {
+	c := &Constraint{}
+	for name, value := range args {
+		if value == nil {
+			continue
+		}
+
+		var err error
+		switch name {
+		case "minLength":
+			c.MinLength, err = constraintInt(name, value)
+		case "maxLength":
+			c.MaxLength, err = constraintInt(name, value)
+		case "min":
+			c.Min, err = constraintFloat(name, value)
+		case "max":
+			c.Max, err = constraintFloat(name, value)
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("pattern must be a string")
+			}
+			c.Pattern, err = regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("pattern is not a valid regexp: %s", err.Error())
+			}
+		case "format":
+			format, _ := value.(string)
+			if !isConstraintFormat(format) {
+				return nil, fmt.Errorf("format must be one of %s", strings.Join(ConstraintFormats, ", "))
+			}
+			c.Format = format
+		default:
+			return nil, fmt.Errorf("unknown argument %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.MinLength != nil && c.MaxLength != nil && *c.MinLength > *c.MaxLength {
+		return nil, fmt.Errorf("minLength is greater than maxLength")
+	}
+	if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+		return nil, fmt.Errorf("min is greater than max")
+	}
+	return c, nil
+}
+
+// MustConstraint is like ParseConstraint but panics if the arguments are invalid. It is used by the generated code,
+// where the arguments have already been checked.
+func MustConstraint(args map[string]interface{}) *Constraint {
+	c, err := ParseConstraint(args)
+	if err != nil {
+		panic("@constraint: " + err.Error())
+	}
+	return c
+}
+
+// HasLength checks if the constraint uses any of the arguments that only apply to strings
+func (c *Constraint) HasLength() bool {
+	return c.MinLength != nil || c.MaxLength != nil || c.Pattern != nil || c.Format != ""
+}
+
+// HasRange checks if the constraint uses any of the arguments that only apply to numbers
+func (c *Constraint) HasRange() bool {
+	return c.Min != nil || c.Max != nil
+}
+
+// Check validates an unmarshaled value, the name is the argument or input field it came from. Null values always
+// pass, and each item of a list is checked on its own.
+func (c *Constraint) Check(name string, v interface{}) error {
+	return c.check(name, reflect.ValueOf(v))
+}
+
+func (c *Constraint) check(name string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return c.check(name, v.Elem())
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := c.check(name, v.Index(i)); err != nil {
+				return err
+			}
+		}
+
+	case reflect.String:
+		return c.checkString(name, v.String())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return c.checkNumber(name, float64(v.Int()))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return c.checkNumber(name, float64(v.Uint()))
+
+	case reflect.Float32, reflect.Float64:
+		return c.checkNumber(name, v.Float())
+	}
+	return nil
+}
+
+func (c *Constraint) checkString(name string, s string) error {
+	length := utf8.RuneCountInString(s)
+	if c.MinLength != nil && length < *c.MinLength {
+		return constraintError(name, "minLength", "must be at least %d characters", *c.MinLength)
+	}
+	if c.MaxLength != nil && length > *c.MaxLength {
+		return constraintError(name, "maxLength", "must be at most %d characters", *c.MaxLength)
+	}
+	if c.Pattern != nil && !c.Pattern.MatchString(s) {
+		return constraintError(name, "pattern", "must match %s", c.Pattern.String())
+	}
+	if c.Format == "email" && !isEmail(s) {
+		return constraintError(name, "format", "must be a valid email address")
+	}
+	return nil
+}
+
+func (c *Constraint) checkNumber(name string, f float64) error {
+	if c.Min != nil && f < *c.Min {
+		return constraintError(name, "min", "must be at least %v", *c.Min)
+	}
+	if c.Max != nil && f > *c.Max {
+		return constraintError(name, "max", "must be at most %v", *c.Max)
+	}
+	return nil
+}
+
+// ConstraintError is returned when an argument or input field doesn't satisfy its @constraint. It is presented to
+// the client with a VALIDATION_FAILED code, the constraint that failed and the path to the value in the input.
+type ConstraintError struct {
+	Path       []string // The argument followed by any input fields, eg input, address, zip
+	Constraint string   // The @constraint argument that failed, eg minLength
+	Message    string
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("%s %s", strings.Join(e.Path, "."), e.Message)
+}
+
+func (e *ConstraintError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":       "VALIDATION_FAILED",
+		"constraint": e.Constraint,
+		"input":      e.Path,
+	}
+}
+
+// PrefixConstraintPath adds the name of the argument or input field that contains a nested input to the path of a
+// ConstraintError, any other error is returned as is
+func PrefixConstraintPath(err error, name string) error {
+	if cerr, ok := err.(*ConstraintError); ok {
+		cerr.Path = append([]string{name}, cerr.Path...)
+	}
+	return err
+}
+
+func constraintError(name string, constraint string, format string, args ...interface{}) error {
+	return &ConstraintError{
+		Path:       []string{name},
+		Constraint: constraint,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func constraintInt(name string, value interface{}) (*int, error) {
+	var i int
+	switch value := value.(type) {
+	case int:
+		i = value
+	case int64:
+		i = int(value)
+	default:
+		return nil, fmt.Errorf("%s must be an int", name)
+	}
+	if i < 0 {
+		return nil, fmt.Errorf("%s must not be negative", name)
+	}
+	return &i, nil
+}
+
+func constraintFloat(name string, value interface{}) (*float64, error) {
+	var f float64
+	switch value := value.(type) {
+	case int:
+		f = float64(value)
+	case int64:
+		f = float64(value)
+	case float64:
+		f = value
+	default:
+		return nil, fmt.Errorf("%s must be a number", name)
+	}
+	return &f, nil
+}
+
+func isConstraintFormat(format string) bool {
+	for _, f := range ConstraintFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmail accepts a bare address like user@example.com, without a display name
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s && strings.Contains(s[strings.LastIndex(s, "@"):], ".")
+}
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraint(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		c := MustConstraint(map[string]interface{}{"minLength": 2, "maxLength": 5, "format": "email"})
+
+		require.NoError(t, c.Check("name", (*string)(nil)))
+		require.EqualError(t, c.Check("name", "é"), "name must be at least 2 characters")
+		require.EqualError(t, c.Check("name", "abcdef"), "name must be at most 5 characters")
+		require.EqualError(t, c.Check("name", "a@bc"), "name must be a valid email address")
+		require.NoError(t, c.Check("name", "a@b.c"))
+	})
+
+	t.Run("lists are checked item by item", func(t *testing.T) {
+		c := MustConstraint(map[string]interface{}{"min": 1, "max": 2.5})
+
+		require.NoError(t, c.Check("ids", []int{1, 2}))
+		require.EqualError(t, c.Check("ids", []*float64{nil, ptrFloat(3)}), "ids must be at most 2.5")
+	})
+
+	t.Run("nested path", func(t *testing.T) {
+		err := PrefixConstraintPath(MustConstraint(map[string]interface{}{"min": 0}).Check("zip", -1), "address")
+		require.EqualError(t, PrefixConstraintPath(err, "input"), "input.address.zip must be at least 0")
+		require.Equal(t, []string{"input", "address", "zip"}, err.(*ConstraintError).Extensions()["input"])
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := ParseConstraint(map[string]interface{}{"minLength": 3, "maxLength": 2})
+		require.EqualError(t, err, "minLength is greater than maxLength")
+
+		_, err = ParseConstraint(map[string]interface{}{"format": "phone"})
+		require.EqualError(t, err, "format must be one of email")
+	})
+}
+
+func ptrFloat(f float64) *float64 {
+	return &f
+}
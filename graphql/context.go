@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/vektah/gqlparser/ast"
 	"github.com/vektah/gqlparser/gqlerror"
@@ -17,11 +18,22 @@ type RequestContext struct {
 	RawQuery  string
 	Variables map[string]interface{}
 	Doc       *ast.QueryDocument
+	// Schema is used to find the possible types of interfaces and unions when building a FieldTree
+	Schema *ast.Schema
 
 	ComplexityLimit      int
 	OperationComplexity  int
 	DisableIntrospection bool
 
+	// ResolverTimeout is the default deadline given to every resolver. A @timeout directive on the field
+	// definition takes precedence. Zero means resolvers will run without a deadline.
+	ResolverTimeout time.Duration
+
+	// ConcurrencyLimit is the maximum number of goroutines that will be used to resolve fields
+	// concurrently for this request. Once they are all busy fields are resolved inline. Zero means
+	// no limit, a goroutine will be started for every concurrent field.
+	ConcurrencyLimit int
+
 	// ErrorPresenter will be used to generate the error
 	// message from errors given to Error().
 	ErrorPresenter      ErrorPresenterFunc
@@ -31,6 +43,8 @@ type RequestContext struct {
 	RequestMiddleware   RequestMiddleware
 	Tracer              Tracer
 
+	workersOnce  sync.Once
+	workers      chan struct{}
 	errorsMu     sync.Mutex
 	Errors       gqlerror.List
 	extensionsMu sync.Mutex
@@ -83,12 +97,25 @@ func WithRequestContext(ctx context.Context, rc *RequestContext) context.Context
 	return context.WithValue(ctx, request, rc)
 }
 
+// ResolverContext describes the field currently being resolved. A new ResolverContext is created for every field
+// and list element, linked to the one above it through Parent.
+//
+// Sibling fields may be resolved concurrently, but a ResolverContext is never modified by the executor once
+// its children have started resolving, so ancestors can safely be read from any goroutine. Treat the Args and
+// Result of ancestors as read only.
+//
+// The ancestor helpers can be called on a nil ResolverContext, so lookups like Ancestor("node").Arg("id") can be
+// chained without checking whether the ancestor exists.
 type ResolverContext struct {
 	Parent *ResolverContext
 	// The name of the type this field belongs to
 	Object string
 	// These are the args after processing, they can be mutated in middleware to change what the resolver will get.
+	// When typed args are enabled this is nil and TypedArgs is set instead.
 	Args map[string]interface{}
+	// A pointer to the generated args struct when typed args are enabled, eg *QueryUserArgs. Like Args it can be
+	// mutated in middleware to change what the resolver will get.
+	TypedArgs interface{}
 	// The raw field
 	Field CollectedField
 	// The index of array in path.
@@ -116,6 +143,134 @@ func (r *ResolverContext) Path() []interface{} {
 	return path
 }
 
+// ParentField returns the closest ancestor that is a field, skipping over list elements. It returns nil for
+// fields on the root types.
+func (r *ResolverContext) ParentField() *ResolverContext {
+	if r == nil {
+		return nil
+	}
+	for it := r.Parent; it != nil; it = it.Parent {
+		if it.Field.Field != nil {
+			return it
+		}
+	}
+	return nil
+}
+
+// Ancestor returns the closest ancestor field with the given schema name, or nil if there is none.
+func (r *ResolverContext) Ancestor(fieldName string) *ResolverContext {
+	for it := r.ParentField(); it != nil; it = it.ParentField() {
+		if it.Field.Name == fieldName {
+			return it
+		}
+	}
+	return nil
+}
+
+// Arg returns a processed argument of this field. It always returns false when typed args are enabled, use TypedArgs
+// instead.
+func (r *ResolverContext) Arg(name string) (interface{}, bool) {
+	if r == nil {
+		return nil, false
+	}
+	val, ok := r.Args[name]
+	return val, ok
+}
+
+// IsArgSet checks if an argument of the field being resolved was given, either in the query or by a variable that was
+// provided. Unlike the processed args it can tell an argument that was explicitly null from one that was omitted, and
+// an argument that was filled in by its default value is not set.
+func IsArgSet(ctx context.Context, name string) bool {
+	rctx := GetResolverContext(ctx)
+	if rctx == nil || rctx.Field.Field == nil {
+		return false
+	}
+
+	arg := rctx.Field.Arguments.ForName(name)
+	if arg == nil {
+		return false
+	}
+	if arg.Value.Kind == ast.Variable {
+		reqCtx := GetRequestContext(ctx)
+		if reqCtx == nil {
+			return false
+		}
+		_, ok := reqCtx.Variables[arg.Value.Raw]
+		return ok
+	}
+	return true
+}
+
+// ArgumentMap coerces the arguments of a field like ast.Field.ArgumentMap, except that an input object field using a
+// variable that wasn't provided, and has no default, is left out rather than being null. The keys of an input object
+// are then only the fields that were given, which is what the IsSet map of a generated input is built from.
+func ArgumentMap(field *ast.Field, vars map[string]interface{}) map[string]interface{} {
+	args := field.ArgumentMap(vars)
+	for _, arg := range field.Arguments {
+		if _, ok := args[arg.Name]; !ok || arg.Value.Kind == ast.Variable {
+			continue
+		}
+		if val, err := givenValue(arg.Value, vars); err == nil {
+			args[arg.Name] = val
+		}
+	}
+	return args
+}
+
+// givenValue is ast.Value.Value without the object fields that use a missing variable
+func givenValue(v *ast.Value, vars map[string]interface{}) (interface{}, error) {
+	switch v.Kind {
+	case ast.ListValue:
+		var val []interface{}
+		for _, elem := range v.Children {
+			elemVal, err := givenValue(elem.Value, vars)
+			if err != nil {
+				return val, err
+			}
+			val = append(val, elemVal)
+		}
+		return val, nil
+	case ast.ObjectValue:
+		val := map[string]interface{}{}
+		for _, elem := range v.Children {
+			if elem.Value.Kind == ast.Variable {
+				_, provided := vars[elem.Value.Raw]
+				if !provided && (elem.Value.VariableDefinition == nil || elem.Value.VariableDefinition.DefaultValue == nil) {
+					continue
+				}
+			}
+			elemVal, err := givenValue(elem.Value, vars)
+			if err != nil {
+				return val, err
+			}
+			val[elem.Name] = elemVal
+		}
+		return val, nil
+	default:
+		return v.Value(vars)
+	}
+}
+
+// ParentResult returns the go value of the object this field belongs to, eg the User when resolving User.friends.
+// This is the value returned by the parent resolver, or a pointer to the element when the parent is a list.
+// It returns nil for fields on the root types.
+func (r *ResolverContext) ParentResult() interface{} {
+	if r == nil || r.Parent == nil {
+		return nil
+	}
+	return r.Parent.Result
+}
+
+// ListIndex returns the index of the closest list element this field is being resolved in.
+func (r *ResolverContext) ListIndex() (int, bool) {
+	for it := r; it != nil; it = it.Parent {
+		if it.Index != nil {
+			return *it.Index, true
+		}
+	}
+	return 0, false
+}
+
 func GetResolverContext(ctx context.Context) *ResolverContext {
 	val, _ := ctx.Value(resolver).(*ResolverContext)
 	return val
@@ -132,6 +287,31 @@ func CollectFieldsCtx(ctx context.Context, satisfies []string) []CollectedField
 	return CollectFields(ctx, resctx.Field.Selections, satisfies)
 }
 
+// Go runs f in a new goroutine, unless ConcurrencyLimit goroutines are already busy with this request, in which
+// case f is run inline before Go returns.
+func (c *RequestContext) Go(f func()) {
+	c.workersOnce.Do(func() {
+		if c.ConcurrencyLimit > 0 {
+			c.workers = make(chan struct{}, c.ConcurrencyLimit)
+		}
+	})
+
+	if c.workers == nil {
+		go f()
+		return
+	}
+
+	select {
+	case c.workers <- struct{}{}:
+		go func() {
+			defer func() { <-c.workers }()
+			f()
+		}()
+	default:
+		f()
+	}
+}
+
 // Errorf sends an error string to the client, passing it through the formatter.
 func (c *RequestContext) Errorf(ctx context.Context, format string, args ...interface{}) {
 	c.errorsMu.Lock()
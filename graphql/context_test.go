@@ -3,7 +3,9 @@ package graphql
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vektah/gqlparser/ast"
@@ -63,3 +65,168 @@ func TestRequestContext_GetErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestContext_Go(t *testing.T) {
+	t.Run("runs inline once the limit is reached", func(t *testing.T) {
+		c := &RequestContext{ConcurrencyLimit: 2}
+
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(2)
+		for i := 0; i < 2; i++ {
+			c.Go(func() {
+				defer wg.Done()
+				<-release
+			})
+		}
+
+		inline := false
+		c.Go(func() {
+			inline = true
+		})
+		assert.True(t, inline)
+
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("never exceeds the limit", func(t *testing.T) {
+		c := &RequestContext{ConcurrencyLimit: 3}
+
+		var mu sync.Mutex
+		var running, maxRunning int
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			c.Go(func() {
+				defer wg.Done()
+				mu.Lock()
+				running++
+				if running > maxRunning {
+					maxRunning = running
+				}
+				mu.Unlock()
+
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				running--
+				mu.Unlock()
+			})
+		}
+		wg.Wait()
+
+		// the calling goroutine may also be running one inline
+		assert.True(t, maxRunning <= 4, "expected at most 4 running, got %d", maxRunning)
+	})
+
+	t.Run("unlimited by default", func(t *testing.T) {
+		c := &RequestContext{}
+
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			c.Go(func() {
+				defer wg.Done()
+				<-release
+			})
+		}
+		close(release)
+		wg.Wait()
+	})
+}
+
+func TestResolverContext_Ancestors(t *testing.T) {
+	type user struct{ name string }
+	users := []user{{"bob"}, {"alice"}}
+
+	ctx := WithResolverContext(context.Background(), &ResolverContext{Object: "Query"})
+	ctx = WithResolverContext(ctx, &ResolverContext{
+		Object: "Query",
+		Args:   map[string]interface{}{"id": "1"},
+		Field:  CollectedField{Field: &ast.Field{Name: "node", Alias: "node"}},
+		Result: users,
+	})
+	index := 1
+	ctx = WithResolverContext(ctx, &ResolverContext{Index: &index, Result: &users[1]})
+	ctx = WithResolverContext(ctx, &ResolverContext{
+		Object: "User",
+		Args:   map[string]interface{}{"first": 10},
+		Field:  CollectedField{Field: &ast.Field{Name: "friends", Alias: "friends"}},
+	})
+	rctx := GetResolverContext(ctx)
+
+	assert.Equal(t, &users[1], rctx.ParentResult())
+	assert.Equal(t, "node", rctx.ParentField().Field.Name)
+	assert.Nil(t, rctx.ParentField().ParentField())
+	assert.Nil(t, rctx.ParentField().ParentResult())
+
+	node := rctx.Ancestor("node")
+	if assert.NotNil(t, node) {
+		id, ok := node.Arg("id")
+		assert.True(t, ok)
+		assert.Equal(t, "1", id)
+	}
+	assert.Nil(t, rctx.Ancestor("friends"))
+	assert.Nil(t, rctx.Ancestor("missing"))
+
+	first, ok := rctx.Arg("first")
+	assert.True(t, ok)
+	assert.Equal(t, 10, first)
+
+	i, ok := rctx.ListIndex()
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+
+	_, ok = rctx.ParentField().ListIndex()
+	assert.False(t, ok)
+
+	_, ok = rctx.Ancestor("missing").Arg("id")
+	assert.False(t, ok)
+	_, ok = rctx.Ancestor("missing").ListIndex()
+	assert.False(t, ok)
+	assert.Nil(t, rctx.Ancestor("missing").ParentResult())
+	assert.Nil(t, rctx.Ancestor("missing").Ancestor("node"))
+}
+
+func TestIsArgSet(t *testing.T) {
+	ctx := WithRequestContext(context.Background(), &RequestContext{Variables: map[string]interface{}{"name": nil}})
+	ctx = WithResolverContext(ctx, &ResolverContext{
+		Object: "Mutation",
+		Field: CollectedField{Field: &ast.Field{Name: "updateUser", Alias: "updateUser", Arguments: ast.ArgumentList{
+			{Name: "id", Value: &ast.Value{Kind: ast.IntValue, Raw: "1"}},
+			{Name: "email", Value: &ast.Value{Kind: ast.NullValue, Raw: "null"}},
+			{Name: "name", Value: &ast.Value{Kind: ast.Variable, Raw: "name"}},
+			{Name: "age", Value: &ast.Value{Kind: ast.Variable, Raw: "age"}},
+		}}},
+	})
+
+	assert.True(t, IsArgSet(ctx, "id"))
+	assert.True(t, IsArgSet(ctx, "email"), "explicit null is set")
+	assert.True(t, IsArgSet(ctx, "name"), "a variable that was given as null is set")
+	assert.False(t, IsArgSet(ctx, "age"), "a variable that wasn't given is not set")
+	assert.False(t, IsArgSet(ctx, "missing"))
+	assert.False(t, IsArgSet(context.Background(), "id"))
+}
+
+func TestArgumentMap(t *testing.T) {
+	withDefault := &ast.VariableDefinition{Variable: "tags", DefaultValue: &ast.Value{Kind: ast.ListValue}}
+	field := &ast.Field{
+		Name: "patch",
+		Arguments: ast.ArgumentList{
+			{Name: "input", Value: &ast.Value{Kind: ast.ObjectValue, Children: ast.ChildValueList{
+				{Name: "name", Value: &ast.Value{Kind: ast.StringValue, Raw: "bob"}},
+				{Name: "age", Value: &ast.Value{Kind: ast.Variable, Raw: "age"}},
+				{Name: "email", Value: &ast.Value{Kind: ast.Variable, Raw: "email"}},
+				{Name: "tags", Value: &ast.Value{Kind: ast.Variable, Raw: "tags", VariableDefinition: withDefault}},
+			}}},
+		},
+		Definition: &ast.FieldDefinition{Name: "patch", Arguments: ast.ArgumentDefinitionList{{Name: "input"}}},
+	}
+
+	args := ArgumentMap(field, map[string]interface{}{"email": nil})
+	assert.Equal(t, map[string]interface{}{
+		"input": map[string]interface{}{"name": "bob", "email": nil, "tags": []interface{}(nil)},
+	}, args)
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/ast"
+)
+
+// DirectiveContext describes the directive whose handler is being called from the DirectiveRoot
+type DirectiveContext struct {
+	Directive *ast.Directive
+	// Location is where the directive was placed. In the schema that is FIELD_DEFINITION, OBJECT, ENUM_VALUE,
+	// ARGUMENT_DEFINITION or INPUT_FIELD_DEFINITION, in the query document it is FIELD, FRAGMENT_SPREAD or
+	// INLINE_FRAGMENT.
+	Location ast.DirectiveLocation
+}
+
+// FromQuery checks if the directive was placed in the query document rather than the schema
+func (d *DirectiveContext) FromQuery() bool {
+	switch d.Location {
+	case ast.LocationField, ast.LocationFragmentSpread, ast.LocationInlineFragment:
+		return true
+	}
+	return false
+}
+
+const directive key = "directive_context"
+
+// GetDirectiveContext returns the directive being called, it is only set inside directive handlers
+func GetDirectiveContext(ctx context.Context) *DirectiveContext {
+	val, _ := ctx.Value(directive).(*DirectiveContext)
+	return val
+}
+
+func WithDirectiveContext(ctx context.Context, dc *DirectiveContext) context.Context {
+	return context.WithValue(ctx, directive, dc)
+}
+
+// EnumValueDirectives returns the directives on the enum values given as arguments to a field, including those
+// inside lists and input objects. Each directive is only returned once, however many times its value was given.
+func EnumValueDirectives(schema *ast.Schema, field CollectedField, vars map[string]interface{}) ast.DirectiveList {
+	if field.Definition == nil || len(field.Definition.Arguments) == 0 {
+		return nil
+	}
+
+	var directives ast.DirectiveList
+	seen := map[*ast.Directive]bool{}
+	var collect func(typ *ast.Type, value interface{})
+	collect = func(typ *ast.Type, value interface{}) {
+		if value == nil {
+			return
+		}
+		if typ.Elem != nil {
+			if list, ok := value.([]interface{}); ok {
+				for _, item := range list {
+					collect(typ.Elem, item)
+				}
+				return
+			}
+			// a single value is accepted where a list is expected
+			collect(typ.Elem, value)
+			return
+		}
+
+		def := schema.Types[typ.NamedType]
+		if def == nil {
+			return
+		}
+		switch def.Kind {
+		case ast.Enum:
+			name, _ := value.(string)
+			if enumValue := def.EnumValues.ForName(name); enumValue != nil {
+				for _, d := range enumValue.Directives {
+					if !seen[d] {
+						seen[d] = true
+						directives = append(directives, d)
+					}
+				}
+			}
+		case ast.InputObject:
+			if fields, ok := value.(map[string]interface{}); ok {
+				for _, fieldDef := range def.Fields {
+					collect(fieldDef.Type, fields[fieldDef.Name])
+				}
+			}
+		}
+	}
+
+	args := field.ArgumentMap(vars)
+	for _, arg := range field.Definition.Arguments {
+		collect(arg.Type, args[arg.Name])
+	}
+	return directives
+}
@@ -39,6 +39,7 @@ func collectFields(reqCtx *RequestContext, selSet ast.SelectionSet, satisfies []
 				continue
 			}
 			for _, childField := range collectFields(reqCtx, sel.SelectionSet, satisfies, visited) {
+				childField.FragmentDirectives = append(childField.FragmentDirectives, sel.Directives...)
 				f := getOrCreateField(&groupedFields, childField.Name, func() CollectedField { return childField })
 				f.Selections = append(f.Selections, childField.Selections...)
 			}
@@ -64,6 +65,7 @@ func collectFields(reqCtx *RequestContext, selSet ast.SelectionSet, satisfies []
 			}
 
 			for _, childField := range collectFields(reqCtx, fragment.SelectionSet, satisfies, visited) {
+				childField.FragmentDirectives = append(childField.FragmentDirectives, sel.Directives...)
 				f := getOrCreateField(&groupedFields, childField.Name, func() CollectedField { return childField })
 				f.Selections = append(f.Selections, childField.Selections...)
 			}
@@ -80,6 +82,17 @@ type CollectedField struct {
 	*ast.Field
 
 	Selections ast.SelectionSet
+	// FragmentDirectives are the directives on the fragment spreads and inline fragments the field was selected
+	// through, from the innermost out
+	FragmentDirectives ast.DirectiveList
+}
+
+// QueryDirectives are the directives on the field in the query document, followed by the directives on any
+// fragments it was selected through. When the same field is selected more than once only the first is used.
+func (f CollectedField) QueryDirectives() ast.DirectiveList {
+	directives := make(ast.DirectiveList, 0, len(f.Directives)+len(f.FragmentDirectives))
+	directives = append(directives, f.Directives...)
+	return append(directives, f.FragmentDirectives...)
 }
 
 func instanceOf(val string, satisfies []string) bool {
@@ -0,0 +1,26 @@
+package graphql
+
+import "context"
+
+// Entity is the go type of the _Entity union in federation mode. Any model can be an entity, so it doesn't need
+// a marker method.
+type Entity interface{}
+
+// FederationService is the go type of _Service, the gateway builds the supergraph from the sdl of each service
+type FederationService struct {
+	SDL string `json:"sdl"`
+}
+
+const representationCtx key = "entity_representation"
+
+// WithEntityRepresentation adds the representation an entity is being found by to the context
+func WithEntityRepresentation(ctx context.Context, representation map[string]interface{}) context.Context {
+	return context.WithValue(ctx, representationCtx, representation)
+}
+
+// GetEntityRepresentation returns the representation sent by the gateway for the entity being found, including the
+// @external fields named by any @requires. It is nil outside of the EntityResolver.
+func GetEntityRepresentation(ctx context.Context) map[string]interface{} {
+	representation, _ := ctx.Value(representationCtx).(map[string]interface{})
+	return representation
+}
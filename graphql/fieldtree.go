@@ -0,0 +1,270 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/ast"
+)
+
+// FieldNode is a field selected by the current query, with fragments flattened and arguments resolved
+// against the request variables. It can be used by resolvers to plan ahead, eg building a SQL projection
+// or preloading associations.
+type FieldNode struct {
+	// The name of the field in the schema
+	Name string
+	// The key the field will have in the response, this is the name unless the field was aliased
+	Alias string
+	// The type this field was selected on
+	Object string
+	// The field arguments, with variables and defaults applied. Built in scalars have the same go type whether
+	// they were given inline or through a variable: Int is an int64, Float a float64, String and ID a string and
+	// Boolean a bool. Enums are strings, input objects are maps and custom scalars are left as they were given.
+	Args map[string]interface{}
+	// The raw field
+	Field *ast.Field
+	// The fields selected below this one, keyed by every concrete type the field may resolve to.
+	// Leaf fields have no children.
+	Children map[string][]*FieldNode
+}
+
+// FieldTree returns the tree of fields selected below the current resolver, rooted at the current field.
+func FieldTree(ctx context.Context) *FieldNode {
+	resCtx := GetResolverContext(ctx)
+	return newFieldNode(GetRequestContext(ctx), resCtx.Object, resCtx.Field)
+}
+
+// Preload returns the dotted path of every field selected below the current resolver, eg
+// []string{"posts", "posts.author", "posts.author.name"}. Aliases are ignored, each path is only returned once.
+func Preload(ctx context.Context) []string {
+	return FieldTree(ctx).Preload()
+}
+
+func newFieldNode(reqCtx *RequestContext, object string, field CollectedField) *FieldNode {
+	node := &FieldNode{
+		Name:   field.Name,
+		Alias:  field.Alias,
+		Object: object,
+		Field:  field.Field,
+	}
+	if field.Definition != nil {
+		node.Args = ArgumentMap(field.Field, reqCtx.Variables)
+		for _, arg := range field.Definition.Arguments {
+			if val, ok := node.Args[arg.Name]; ok {
+				node.Args[arg.Name] = reqCtx.coerceArg(arg.Type, val)
+			}
+		}
+	}
+
+	if len(field.Selections) == 0 || field.Definition == nil {
+		return node
+	}
+
+	node.Children = map[string][]*FieldNode{}
+	for _, typ := range reqCtx.concreteTypes(field.Definition.Type.Name(), field.Selections) {
+		children := []*FieldNode{}
+		for _, child := range collectFields(reqCtx, field.Selections, reqCtx.satisfies(typ), map[string]bool{}) {
+			children = append(children, newFieldNode(reqCtx, typ, child))
+		}
+		node.Children[typ] = children
+	}
+
+	return node
+}
+
+// coerceArg converts an argument value to the go type used for its graphql type, literals and variables are
+// decoded into different types. Lists and maps are copied rather than modified, they may belong to the variables.
+func (c *RequestContext) coerceArg(typ *ast.Type, val interface{}) interface{} {
+	if val == nil {
+		return nil
+	}
+
+	if typ.Elem != nil {
+		list, ok := val.([]interface{})
+		if !ok {
+			return []interface{}{c.coerceArg(typ.Elem, val)}
+		}
+		coerced := make([]interface{}, len(list))
+		for i, elem := range list {
+			coerced[i] = c.coerceArg(typ.Elem, elem)
+		}
+		return coerced
+	}
+
+	var coerced interface{}
+	var err error
+	switch typ.NamedType {
+	case "Int":
+		var i int
+		i, err = UnmarshalInt(val)
+		coerced = int64(i)
+	case "Float":
+		coerced, err = UnmarshalFloat(val)
+	case "String", "ID":
+		if i, ok := val.(int64); ok {
+			return strconv.FormatInt(i, 10)
+		}
+		coerced, err = UnmarshalID(val)
+	case "Boolean":
+		coerced, err = UnmarshalBoolean(val)
+	default:
+		obj, ok := val.(map[string]interface{})
+		if !ok || c.Schema == nil || c.Schema.Types[typ.NamedType] == nil {
+			return val
+		}
+		fields := map[string]interface{}{}
+		for name, fieldVal := range obj {
+			fields[name] = fieldVal
+			if def := c.Schema.Types[typ.NamedType].Fields.ForName(name); def != nil {
+				fields[name] = c.coerceArg(def.Type, fieldVal)
+			}
+		}
+		return fields
+	}
+	if err != nil {
+		return val
+	}
+	return coerced
+}
+
+// concreteTypes returns every object type that a field of the named type may resolve to. Without a schema the
+// type conditions in the selection set are used instead.
+func (c *RequestContext) concreteTypes(typeName string, selSet ast.SelectionSet) []string {
+	if c.Schema != nil {
+		def := c.Schema.Types[typeName]
+		if def == nil || def.Kind == ast.Object {
+			return []string{typeName}
+		}
+
+		var types []string
+		for _, possible := range c.Schema.GetPossibleTypes(def) {
+			types = append(types, possible.Name)
+		}
+		return types
+	}
+
+	types := []string{typeName}
+	c.walkTypeConditions(selSet, map[string]bool{}, func(typeCondition string) {
+		for _, typ := range types {
+			if typ == typeCondition {
+				return
+			}
+		}
+		types = append(types, typeCondition)
+	})
+	return types
+}
+
+func (c *RequestContext) walkTypeConditions(selSet ast.SelectionSet, visited map[string]bool, f func(typeCondition string)) {
+	for _, sel := range selSet {
+		switch sel := sel.(type) {
+		case *ast.InlineFragment:
+			if sel.TypeCondition != "" {
+				f(sel.TypeCondition)
+			}
+			c.walkTypeConditions(sel.SelectionSet, visited, f)
+		case *ast.FragmentSpread:
+			if visited[sel.Name] {
+				continue
+			}
+			visited[sel.Name] = true
+			if fragment := c.Doc.Fragments.ForName(sel.Name); fragment != nil {
+				f(fragment.TypeCondition)
+				c.walkTypeConditions(fragment.SelectionSet, visited, f)
+			}
+		}
+	}
+}
+
+// satisfies returns the type names that fragments on the given type may be conditioned on
+func (c *RequestContext) satisfies(typeName string) []string {
+	satisfies := []string{typeName}
+	if c.Schema == nil {
+		return satisfies
+	}
+	if def := c.Schema.Types[typeName]; def != nil {
+		for _, impl := range c.Schema.GetImplements(def) {
+			satisfies = append(satisfies, impl.Name)
+		}
+	}
+	return satisfies
+}
+
+// Fields returns the fields selected below this field when it resolves to the given concrete type.
+func (n *FieldNode) Fields(typeName string) []*FieldNode {
+	return n.Children[typeName]
+}
+
+// Child returns the first field selected below this one with the given name or alias, for any concrete type.
+func (n *FieldNode) Child(name string) *FieldNode {
+	for _, typ := range n.childTypes() {
+		for _, child := range n.Children[typ] {
+			if child.Alias == name {
+				return child
+			}
+		}
+	}
+	for _, typ := range n.childTypes() {
+		for _, child := range n.Children[typ] {
+			if child.Name == name {
+				return child
+			}
+		}
+	}
+	return nil
+}
+
+// HasField checks if a dotted path of field names, eg "posts.author.name", has been selected below this field
+// for any concrete type.
+func (n *FieldNode) HasField(path string) bool {
+	return n.hasField(strings.Split(path, "."))
+}
+
+func (n *FieldNode) hasField(path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+
+	for _, typ := range n.childTypes() {
+		for _, child := range n.Children[typ] {
+			if child.Name == path[0] && child.hasField(path[1:]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Preload returns the dotted path of every field selected below this one. Paths are in query order for each
+// concrete type, and concrete types are visited in name order.
+func (n *FieldNode) Preload() []string {
+	var paths []string
+	seen := map[string]bool{}
+	n.preload("", seen, &paths)
+	return paths
+}
+
+func (n *FieldNode) preload(prefix string, seen map[string]bool, paths *[]string) {
+	for _, typ := range n.childTypes() {
+		for _, child := range n.Children[typ] {
+			path := prefix + child.Name
+			if !seen[path] {
+				seen[path] = true
+				*paths = append(*paths, path)
+			}
+			child.preload(path+".", seen, paths)
+		}
+	}
+}
+
+// childTypes returns the keys of Children in a stable order
+func (n *FieldNode) childTypes() []string {
+	types := make([]string, 0, len(n.Children))
+	for typ := range n.Children {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,137 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser"
+	"github.com/vektah/gqlparser/ast"
+)
+
+var fieldTreeSchema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+	type Query {
+		user(id: Int!): User
+		search(text: String!): [Result!]
+	}
+	interface Node { id: Int! }
+	type User implements Node {
+		id: Int!
+		name: String!
+		posts(first: Int = 10): [Post!]
+		filter(ids: [ID!], score: Float, where: PostFilter): [Post!]
+	}
+	input PostFilter { name: String, ids: [Int!] }
+	type Post implements Node {
+		id: Int!
+		title: String!
+		author: User!
+	}
+	union Result = User | Post
+`})
+
+func fieldTreeCtx(t *testing.T, query string, variables map[string]interface{}) context.Context {
+	doc, err := gqlparser.LoadQuery(fieldTreeSchema, query)
+	require.Nil(t, err)
+
+	reqCtx := NewRequestContext(doc, query, variables)
+	reqCtx.Schema = fieldTreeSchema
+	ctx := WithRequestContext(context.Background(), reqCtx)
+
+	fields := CollectFields(ctx, doc.Operations[0].SelectionSet, []string{"Query"})
+	require.Len(t, fields, 1)
+
+	return WithResolverContext(ctx, &ResolverContext{Object: "Query", Field: fields[0]})
+}
+
+func TestFieldTree(t *testing.T) {
+	t.Run("nested fields with args and aliases", func(t *testing.T) {
+		ctx := fieldTreeCtx(t, `query($first: Int) {
+			user(id: 1) {
+				name
+				recent: posts(first: $first) { title author { name } }
+				posts { id }
+			}
+		}`, map[string]interface{}{"first": 3})
+
+		tree := FieldTree(ctx)
+		require.Equal(t, "user", tree.Name)
+		require.Equal(t, map[string]interface{}{"id": int64(1)}, tree.Args)
+
+		users := tree.Fields("User")
+		require.Len(t, users, 3)
+		require.Equal(t, "name", users[0].Name)
+
+		recent := tree.Child("recent")
+		require.Equal(t, "posts", recent.Name)
+		require.Equal(t, "recent", recent.Alias)
+		require.Equal(t, "User", recent.Object)
+		require.Equal(t, map[string]interface{}{"first": int64(3)}, recent.Args)
+
+		posts := tree.Child("posts")
+		require.Equal(t, "posts", posts.Alias)
+		require.Equal(t, map[string]interface{}{"first": int64(10)}, posts.Args)
+
+		require.True(t, tree.HasField("posts.author.name"))
+		require.True(t, tree.HasField("posts.id"))
+		require.False(t, tree.HasField("posts.author.posts"))
+		require.False(t, tree.HasField("recent"))
+
+		require.Equal(t, []string{"name", "posts", "posts.title", "posts.author", "posts.author.name", "posts.id"}, Preload(ctx))
+	})
+
+	t.Run("fragments are flattened per concrete type", func(t *testing.T) {
+		ctx := fieldTreeCtx(t, `{
+			search(text: "x") {
+				... on Node { id }
+				... on User { name }
+				...PostFields
+			}
+		}
+		fragment PostFields on Post { title }`, nil)
+
+		tree := FieldTree(ctx)
+		require.Len(t, tree.Children, 2)
+
+		var userFields, postFields []string
+		for _, f := range tree.Fields("User") {
+			userFields = append(userFields, f.Name)
+		}
+		for _, f := range tree.Fields("Post") {
+			postFields = append(postFields, f.Name)
+		}
+		require.Equal(t, []string{"id", "name"}, userFields)
+		require.Equal(t, []string{"id", "title"}, postFields)
+
+		require.True(t, tree.HasField("title"))
+		require.Equal(t, []string{"id", "title", "name"}, tree.Preload())
+	})
+
+	t.Run("args have the same types for literals and variables", func(t *testing.T) {
+		literal := FieldTree(fieldTreeCtx(t, `{
+			user(id: 1) { filter(ids: [2], score: 1, where: {name: "bob", ids: 3}) { id } }
+		}`, nil)).Child("filter")
+		variables := FieldTree(fieldTreeCtx(t, `query($ids: [ID!], $score: Float, $where: PostFilter) {
+			user(id: 1) { filter(ids: $ids, score: $score, where: $where) { id } }
+		}`, map[string]interface{}{
+			"ids":   []interface{}{json.Number("2")},
+			"score": json.Number("1"),
+			"where": map[string]interface{}{"name": "bob", "ids": []interface{}{json.Number("3")}},
+		})).Child("filter")
+
+		expected := map[string]interface{}{
+			"ids":   []interface{}{"2"},
+			"score": float64(1),
+			"where": map[string]interface{}{"name": "bob", "ids": []interface{}{int64(3)}},
+		}
+		require.Equal(t, expected, literal.Args)
+		require.Equal(t, expected, variables.Args)
+	})
+
+	t.Run("skipped fields are excluded", func(t *testing.T) {
+		ctx := fieldTreeCtx(t, `query($skip: Boolean!) { user(id: 1) { name @skip(if: $skip) id } }`, map[string]interface{}{"skip": true})
+
+		require.Equal(t, []string{"id"}, Preload(ctx))
+	})
+}
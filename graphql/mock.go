@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MockScalars are the scalars a generated MockResolver makes fake values for, other scalars are left as their zero
+// value unless the field has a @mock(value:)
+var MockScalars = []string{"Boolean", "Float", "ID", "Int", "Map", "String", "Time"}
+
+var mockWords = []string{
+	"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do", "eiusmod",
+	"tempor", "incididunt", "ut", "labore", "et", "dolore", "magna", "aliqua", "enim", "ad", "minim", "veniam",
+}
+
+// mockEpoch is the earliest fake Time, so the times don't depend on when the mock is run
+var mockEpoch = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// MockRand returns the random source for the field being resolved by a MockResolver. It is seeded from the seed
+// and the path to the field, so a query always gets the same data no matter what order the fields resolve in.
+func MockRand(ctx context.Context, seed int64) *rand.Rand {
+	h := fnv.New64a()
+	if rctx := GetResolverContext(ctx); rctx != nil {
+		for _, p := range rctx.Path() {
+			fmt.Fprintf(h, "%v.", p)
+		}
+	}
+	return rand.New(rand.NewSource(seed ^ int64(h.Sum64())))
+}
+
+// MockScalar makes a fake raw value for one of the MockScalars, in the form its unmarshaler expects
+func MockScalar(rnd *rand.Rand, scalar string) interface{} {
+	switch scalar {
+	case "Boolean":
+		return rnd.Intn(2) == 0
+	case "Float":
+		return float64(rnd.Intn(100000)) / 100
+	case "ID":
+		return strconv.Itoa(rnd.Intn(1000000))
+	case "Int":
+		return rnd.Intn(1000)
+	case "Map":
+		return map[string]interface{}{}
+	case "Time":
+		return mockEpoch.Add(time.Duration(rnd.Int63n(int64(365 * 24 * time.Hour)))).Format(time.RFC3339)
+	default:
+		words := make([]string, 1+rnd.Intn(3))
+		for i := range words {
+			words[i] = mockWords[rnd.Intn(len(mockWords))]
+		}
+		return strings.Join(words, " ")
+	}
+}
+
+// MockEnum picks one of the values of an enum
+func MockEnum(rnd *rand.Rand, values ...string) string {
+	return values[rnd.Intn(len(values))]
+}
+
+// IsMockScalar checks if a MockResolver can make fake values for a scalar
+func IsMockScalar(scalar string) bool {
+	for _, s := range MockScalars {
+		if s == scalar {
+			return true
+		}
+	}
+	return false
+}
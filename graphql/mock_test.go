@@ -0,0 +1,46 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+)
+
+func TestMockRand(t *testing.T) {
+	field := func(alias string) context.Context {
+		return WithResolverContext(context.Background(), &ResolverContext{
+			Field: CollectedField{Field: &ast.Field{Alias: alias}},
+		})
+	}
+
+	require.Equal(t, MockRand(field("me"), 1).Int63(), MockRand(field("me"), 1).Int63())
+	require.NotEqual(t, MockRand(field("me"), 1).Int63(), MockRand(field("me"), 2).Int63())
+	require.NotEqual(t, MockRand(field("me"), 1).Int63(), MockRand(field("you"), 1).Int63())
+}
+
+func TestMockScalar(t *testing.T) {
+	rnd := MockRand(context.Background(), 1)
+
+	_, err := UnmarshalBoolean(MockScalar(rnd, "Boolean"))
+	require.NoError(t, err)
+	_, err = UnmarshalFloat(MockScalar(rnd, "Float"))
+	require.NoError(t, err)
+	_, err = UnmarshalID(MockScalar(rnd, "ID"))
+	require.NoError(t, err)
+	_, err = UnmarshalInt(MockScalar(rnd, "Int"))
+	require.NoError(t, err)
+	_, err = UnmarshalMap(MockScalar(rnd, "Map"))
+	require.NoError(t, err)
+	_, err = UnmarshalTime(MockScalar(rnd, "Time"))
+	require.NoError(t, err)
+
+	str, err := UnmarshalString(MockScalar(rnd, "String"))
+	require.NoError(t, err)
+	require.NotEmpty(t, str)
+
+	require.Contains(t, []string{"A", "B"}, MockEnum(rnd, "A", "B"))
+	require.True(t, IsMockScalar("Time"))
+	require.False(t, IsMockScalar("Upload"))
+}
@@ -0,0 +1,126 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EncodeGlobalID makes the opaque id relay uses to refetch a Node, from the name of its type and its own id
+func EncodeGlobalID(typename string, id string) string {
+	return base64.URLEncoding.EncodeToString([]byte(typename + ":" + id))
+}
+
+// DecodeGlobalID splits an id made by EncodeGlobalID back into the type name and the id of the value
+func DecodeGlobalID(globalID string) (typename string, id string, err error) {
+	raw, err := base64.URLEncoding.DecodeString(globalID)
+	if err != nil {
+		return "", "", fmt.Errorf("%s is not a valid id", globalID)
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("%s is not a valid id", globalID)
+	}
+	return parts[0], parts[1], nil
+}
+
+const cursorPrefix = "cursor:"
+
+// EncodeCursor makes an opaque connection cursor for the item at an offset in a list
+func EncodeCursor(offset int) string {
+	return base64.URLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+// DecodeCursor returns the offset of the item a cursor made by EncodeCursor points to
+func DecodeCursor(cursor string) (int, error) {
+	raw, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(raw), cursorPrefix) {
+		return 0, fmt.Errorf("%s is not a valid cursor", cursor)
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%s is not a valid cursor", cursor)
+	}
+	return offset, nil
+}
+
+// Page is the part of a list selected by the relay connection arguments, the items in it are list[Start:End]
+type Page struct {
+	Start           int
+	End             int
+	HasPreviousPage bool
+	HasNextPage     bool
+}
+
+// Paginate applies the relay connection arguments to a list of count items, using cursors made by EncodeCursor.
+// It follows the pagination algorithm from the relay connection spec.
+func Paginate(count int, first *int, after *string, last *int, before *string) (Page, error) {
+	lowerBound, upperBound := 0, count
+	if after != nil {
+		offset, err := DecodeCursor(*after)
+		if err != nil {
+			return Page{}, err
+		}
+		lowerBound = minInt(offset+1, count)
+	}
+	if before != nil {
+		offset, err := DecodeCursor(*before)
+		if err != nil {
+			return Page{}, err
+		}
+		upperBound = minInt(offset, count)
+	}
+	if upperBound < lowerBound {
+		upperBound = lowerBound
+	}
+
+	page := Page{Start: lowerBound, End: upperBound}
+	if first != nil {
+		if *first < 0 {
+			return Page{}, fmt.Errorf("first must not be negative")
+		}
+		page.End = minInt(page.End, page.Start+*first)
+		page.HasNextPage = page.End < upperBound
+	}
+	if last != nil {
+		if *last < 0 {
+			return Page{}, fmt.Errorf("last must not be negative")
+		}
+		if page.End-*last > page.Start {
+			page.Start = page.End - *last
+		}
+		page.HasPreviousPage = page.Start > lowerBound
+	}
+	return page, nil
+}
+
+// Cursor is the cursor for an item in the page, where 0 is the first item
+func (p Page) Cursor(i int) string {
+	return EncodeCursor(p.Start + i)
+}
+
+// StartCursor is the cursor of the first item in the page, or nil when the page is empty
+func (p Page) StartCursor() *string {
+	if p.Start == p.End {
+		return nil
+	}
+	cursor := p.Cursor(0)
+	return &cursor
+}
+
+// EndCursor is the cursor of the last item in the page, or nil when the page is empty
+func (p Page) EndCursor() *string {
+	if p.Start == p.End {
+		return nil
+	}
+	cursor := p.Cursor(p.End - p.Start - 1)
+	return &cursor
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
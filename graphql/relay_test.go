@@ -0,0 +1,84 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalID(t *testing.T) {
+	typename, id, err := DecodeGlobalID(EncodeGlobalID("User", "12:34"))
+	require.NoError(t, err)
+	require.Equal(t, "User", typename)
+	require.Equal(t, "12:34", id)
+
+	_, _, err = DecodeGlobalID("VXNlcg==")
+	require.EqualError(t, err, "VXNlcg== is not a valid id")
+
+	_, _, err = DecodeGlobalID("!")
+	require.EqualError(t, err, "! is not a valid id")
+}
+
+func TestCursor(t *testing.T) {
+	offset, err := DecodeCursor(EncodeCursor(3))
+	require.NoError(t, err)
+	require.Equal(t, 3, offset)
+
+	_, err = DecodeCursor(EncodeGlobalID("User", "3"))
+	require.Error(t, err)
+}
+
+func TestPaginate(t *testing.T) {
+	cursor := func(offset int) *string {
+		c := EncodeCursor(offset)
+		return &c
+	}
+
+	tests := []struct {
+		name   string
+		first  *int
+		after  *string
+		last   *int
+		before *string
+		page   Page
+	}{
+		{name: "everything", page: Page{Start: 0, End: 5}},
+		{name: "first", first: ptrInt(2), page: Page{Start: 0, End: 2, HasNextPage: true}},
+		{name: "first after", first: ptrInt(2), after: cursor(2), page: Page{Start: 3, End: 5}},
+		{name: "last", last: ptrInt(2), page: Page{Start: 3, End: 5, HasPreviousPage: true}},
+		{name: "last before", last: ptrInt(2), before: cursor(1), page: Page{Start: 0, End: 1}},
+		{name: "after and before", after: cursor(0), before: cursor(4), page: Page{Start: 1, End: 4}},
+		{name: "after the end", first: ptrInt(2), after: cursor(10), page: Page{Start: 5, End: 5}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := Paginate(5, tc.first, tc.after, tc.last, tc.before)
+			require.NoError(t, err)
+			require.Equal(t, tc.page, page)
+		})
+	}
+
+	t.Run("cursors", func(t *testing.T) {
+		page, err := Paginate(5, ptrInt(2), cursor(0), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, cursor(1), page.StartCursor())
+		require.Equal(t, cursor(2), page.EndCursor())
+
+		page, err = Paginate(5, ptrInt(0), nil, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, page.StartCursor())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := Paginate(5, ptrInt(-1), nil, nil, nil)
+		require.EqualError(t, err, "first must not be negative")
+
+		_, err = Paginate(5, nil, nil, nil, &[]string{"x"}[0])
+		require.EqualError(t, err, "x is not a valid cursor")
+	})
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
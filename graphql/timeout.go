@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TimeoutError is returned when a resolver does not complete before its deadline. It is presented to the
+// client with a TIMEOUT code in the error extensions.
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("resolver timed out after %s", e.Timeout)
+}
+
+func (e *TimeoutError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": "TIMEOUT",
+	}
+}
+
+const fieldTimeout key = "field_timeout"
+
+// WithFieldTimeout sets the timeout that will be applied to the resolver of the current field.
+// A timeout of zero disables the deadline.
+func WithFieldTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, fieldTimeout, timeout)
+}
+
+// GetFieldTimeout returns the timeout that will be applied to the resolver of the current field.
+func GetFieldTimeout(ctx context.Context) time.Duration {
+	timeout, _ := ctx.Value(fieldTimeout).(time.Duration)
+	return timeout
+}
+
+type timeoutResult struct {
+	res      interface{}
+	err      error
+	panicked interface{}
+}
+
+// ResolveWithTimeout calls the resolver with a context that has the current field timeout as its deadline.
+// If the deadline passes before the resolver returns a TimeoutError is returned and the result of the
+// resolver is discarded once it eventually completes. Without a field timeout the resolver is called directly.
+func ResolveWithTimeout(ctx context.Context, resolver Resolver) (interface{}, error) {
+	timeout := GetFieldTimeout(ctx)
+	if timeout <= 0 {
+		return resolver(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan timeoutResult, 1)
+	go func() {
+		var result timeoutResult
+		defer func() {
+			result.panicked = recover()
+			done <- result
+		}()
+		result.res, result.err = resolver(ctx)
+	}()
+
+	select {
+	case result := <-done:
+		if result.panicked != nil {
+			// rethrow on the calling goroutine so the field middleware can recover it
+			panic(result.panicked)
+		}
+		return result.res, result.err
+	case <-ctx.Done():
+		if ctx.Err() != context.DeadlineExceeded {
+			return nil, ctx.Err()
+		}
+		return nil, &TimeoutError{Timeout: timeout}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/complexity"
 	"github.com/99designs/gqlgen/graphql"
@@ -34,11 +35,16 @@ type Config struct {
 	tracer               graphql.Tracer
 	complexityLimit      int
 	disableIntrospection bool
+	resolverTimeout      time.Duration
+	concurrencyLimit     int
 }
 
 func (c *Config) newRequestContext(es graphql.ExecutableSchema, doc *ast.QueryDocument, op *ast.OperationDefinition, query string, variables map[string]interface{}) *graphql.RequestContext {
 	reqCtx := graphql.NewRequestContext(doc, query, variables)
+	reqCtx.Schema = es.Schema()
 	reqCtx.DisableIntrospection = c.disableIntrospection
+	reqCtx.ResolverTimeout = c.resolverTimeout
+	reqCtx.ConcurrencyLimit = c.concurrencyLimit
 
 	if hook := c.recover; hook != nil {
 		reqCtx.Recover = hook
@@ -110,6 +116,23 @@ func ComplexityLimit(limit int) Option {
 	}
 }
 
+// ResolverTimeout sets a deadline for every resolver that doesn't have its own @timeout directive. When the
+// deadline passes the field will resolve to null and a TIMEOUT error will be added to the response.
+func ResolverTimeout(timeout time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.resolverTimeout = timeout
+	}
+}
+
+// ConcurrencyLimit sets the maximum number of goroutines each request may use to resolve fields. When they are
+// all busy the remaining fields are resolved inline. If unset the limit from the generated Config is used, and
+// if that is also unset a goroutine is started for every field that can be resolved concurrently.
+func ConcurrencyLimit(limit int) Option {
+	return func(cfg *Config) {
+		cfg.concurrencyLimit = limit
+	}
+}
+
 // ResolverMiddleware allows you to define a function that will be called around every resolver,
 // useful for logging.
 func ResolverMiddleware(middleware graphql.FieldMiddleware) Option {
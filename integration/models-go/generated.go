@@ -3,6 +3,8 @@
 package models
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
@@ -25,6 +27,15 @@ const (
 	DateFilterOpLte DateFilterOp = "LTE"
 )
 
+var AllDateFilterOp = []DateFilterOp{
+	DateFilterOpEq,
+	DateFilterOpNeq,
+	DateFilterOpGt,
+	DateFilterOpGte,
+	DateFilterOpLt,
+	DateFilterOpLte,
+}
+
 func (e DateFilterOp) IsValid() bool {
 	switch e {
 	case DateFilterOpEq, DateFilterOpNeq, DateFilterOpGt, DateFilterOpGte, DateFilterOpLt, DateFilterOpLte:
@@ -45,7 +56,7 @@ func (e *DateFilterOp) UnmarshalGQL(v interface{}) error {
 
 	*e = DateFilterOp(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid DATE_FILTER_OP", str)
+		return fmt.Errorf("%s is not a valid DATE_FILTER_OP, expected one of EQ, NEQ, GT, GTE, LT, LTE", str)
 	}
 	return nil
 }
@@ -54,6 +65,23 @@ func (e DateFilterOp) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+func (e *DateFilterOp) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("DATE_FILTER_OP must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e DateFilterOp) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ErrorType string
 
 const (
@@ -61,6 +89,11 @@ const (
 	ErrorTypeNormal ErrorType = "NORMAL"
 )
 
+var AllErrorType = []ErrorType{
+	ErrorTypeCustom,
+	ErrorTypeNormal,
+}
+
 func (e ErrorType) IsValid() bool {
 	switch e {
 	case ErrorTypeCustom, ErrorTypeNormal:
@@ -81,7 +114,7 @@ func (e *ErrorType) UnmarshalGQL(v interface{}) error {
 
 	*e = ErrorType(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ErrorType", str)
+		return fmt.Errorf("%s is not a valid ErrorType, expected one of CUSTOM, NORMAL", str)
 	}
 	return nil
 }
@@ -89,3 +122,20 @@ func (e *ErrorType) UnmarshalGQL(v interface{}) error {
 func (e ErrorType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+func (e *ErrorType) UnmarshalJSON(b []byte) error {
+	var str *string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("ErrorType must be a json string")
+	}
+	if str == nil {
+		return nil
+	}
+	return e.UnmarshalGQL(*str)
+}
+
+func (e ErrorType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -1,33 +1,59 @@
 package gopath
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"go/build"
+	"io/ioutil"
+	"os"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 )
 
-var NotFound = fmt.Errorf("not on GOPATH")
+var NotFound = fmt.Errorf("not on GOPATH or in a go module")
 
-// Contains returns true if the given directory is in the GOPATH
+// Contains returns true if the given directory is in the GOPATH or inside a go module
 func Contains(dir string) bool {
 	_, err := Dir2Import(dir)
 	return err == nil
 }
 
-// Dir2Import takes an *absolute* path and returns a golang import path for the package, and returns an error if it isn't on the gopath
+// Dir2Import takes an *absolute* path and returns a golang import path for the package. Directories inside a go
+// module are resolved relative to the module path in go.mod, otherwise the directory must be on the GOPATH.
+// The directory does not need to exist yet.
 func Dir2Import(dir string) (string, error) {
 	dir = filepath.ToSlash(dir)
+
+	if modDir, modPath := findModule(dir); modPath != "" {
+		if len(dir) == len(modDir) {
+			return modPath, nil
+		}
+		return path.Join(modPath, dir[len(modDir)+1:]), nil
+	}
+
+	if pkg, ok := gopathImport(dir); ok {
+		return pkg, nil
+	}
+	return "", NotFound
+}
+
+// gopathImport returns the import path of a directory under GOPATH/src
+func gopathImport(dir string) (string, bool) {
 	for _, gopath := range filepath.SplitList(build.Default.GOPATH) {
 		gopath = filepath.ToSlash(filepath.Join(gopath, "src"))
 		if len(gopath) < len(dir) && strings.EqualFold(gopath, dir[0:len(gopath)]) {
-			return dir[len(gopath)+1:], nil
+			return dir[len(gopath)+1:], true
 		}
 	}
-	return "", NotFound
+	return "", false
 }
 
-// MustDir2Import takes an *absolute* path and returns a golang import path for the package, and panics if it isn't on the gopath
+// MustDir2Import takes an *absolute* path and returns a golang import path for the package, and panics if it isn't
+// on the GOPATH or in a go module
 func MustDir2Import(dir string) string {
 	pkg, err := Dir2Import(dir)
 	if err != nil {
@@ -35,3 +61,97 @@ func MustDir2Import(dir string) string {
 	}
 	return pkg
 }
+
+var modules = struct {
+	sync.Mutex
+	paths map[string]string
+}{paths: map[string]string{}}
+
+// findModule walks up from dir looking for a go.mod, returning the module root and module path. Like the go
+// command, modules are always used when GO111MODULE=on and never when it is off. Otherwise (auto) a go.mod is only
+// used outside of the GOPATH.
+func findModule(dir string) (string, string) {
+	switch os.Getenv("GO111MODULE") {
+	case "on":
+	case "off":
+		return "", ""
+	default:
+		if _, ok := gopathImport(dir); ok {
+			return "", ""
+		}
+	}
+
+	modules.Lock()
+	defer modules.Unlock()
+
+	for {
+		modPath, ok := modules.paths[dir]
+		if !ok {
+			modPath = readModulePath(filepath.Join(filepath.FromSlash(dir), "go.mod"))
+			modules.paths[dir] = modPath
+		}
+		if modPath != "" {
+			return dir, modPath
+		}
+
+		parent := path.Dir(dir)
+		if parent == dir || parent == "." || strings.HasSuffix(parent, ":") {
+			return "", ""
+		}
+		dir = parent
+	}
+}
+
+// readModulePath returns the module path declared in a go.mod file, or an empty string if there isn't one
+func readModulePath(filename string) string {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "module" {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(fields[1]); err == nil {
+			return unquoted
+		}
+		return fields[1]
+	}
+	return ""
+}
+
+// Import finds the package for an import path relative to srcDir. When srcDir is inside a go module the lookup is
+// delegated to the go command, so srcDir is moved up to the closest directory that already exists.
+func Import(importPath string, srcDir string, mode build.ImportMode) (*build.Package, error) {
+	for {
+		if _, err := os.Stat(srcDir); err == nil || filepath.Dir(srcDir) == srcDir {
+			break
+		}
+		srcDir = filepath.Dir(srcDir)
+	}
+	return build.Default.Import(importPath, srcDir, mode)
+}
+
+// ResolveVendor returns the import path a package will have when it is imported from srcDir, taking GOPATH vendor
+// directories into account. Go modules handle vendoring themselves, so inside a module the path is not changed.
+func ResolveVendor(importPath string, srcDir string) string {
+	srcDir = filepath.ToSlash(srcDir)
+	if _, modPath := findModule(srcDir); modPath != "" {
+		return importPath
+	}
+
+	for dir := srcDir; Contains(dir); dir = path.Dir(dir) {
+		vendored := dir + "/vendor/" + importPath
+		if info, err := os.Stat(filepath.FromSlash(vendored)); err == nil && info.IsDir() {
+			return MustDir2Import(vendored)
+		}
+	}
+	return importPath
+}
@@ -2,10 +2,14 @@ package gopath
 
 import (
 	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestContains(t *testing.T) {
@@ -60,3 +64,50 @@ func TestDir2Package(t *testing.T) {
 		})
 	}
 }
+
+func TestDir2ImportModules(t *testing.T) {
+	origBuildContext := build.Default
+	defer func() { build.Default = origBuildContext }()
+	origModules := os.Getenv("GO111MODULE")
+	defer os.Setenv("GO111MODULE", origModules)
+	os.Setenv("GO111MODULE", "on")
+
+	tmp, err := ioutil.TempDir("", "gopath")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	tmp = filepath.ToSlash(tmp)
+
+	build.Default.GOPATH = tmp
+	require.NoError(t, os.MkdirAll(filepath.Join(tmp, "src", "gopathpkg"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(tmp, "mono", "svc", "graph"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmp, "mono", "go.mod"), []byte("// the monorepo\nmodule \"example.com/mono\" // root\n\nrequire github.com/99designs/gqlgen v0.7.0\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmp, "mono", "svc", "go.mod"), []byte("module example.com/svc\n"), 0644))
+
+	assert.Equal(t, "example.com/mono", MustDir2Import(tmp+"/mono"))
+	assert.Equal(t, "example.com/svc", MustDir2Import(tmp+"/mono/svc"))
+	assert.Equal(t, "example.com/svc/graph", MustDir2Import(tmp+"/mono/svc/graph"))
+	assert.Equal(t, "example.com/svc/graph/generated", MustDir2Import(tmp+"/mono/svc/graph/generated"))
+	assert.Equal(t, "gopathpkg", MustDir2Import(tmp+"/src/gopathpkg"))
+	assert.False(t, Contains(tmp+"/elsewhere"))
+
+	t.Run("modules disabled", func(t *testing.T) {
+		os.Setenv("GO111MODULE", "off")
+
+		assert.False(t, Contains(tmp+"/mono/svc"))
+	})
+
+	t.Run("modules on the GOPATH", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmp, "src", "modpkg", "graph"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(tmp, "src", "modpkg", "go.mod"), []byte("module example.com/modpkg\n"), 0644))
+
+		os.Setenv("GO111MODULE", "on")
+		assert.Equal(t, "example.com/modpkg/graph", MustDir2Import(tmp+"/src/modpkg/graph"))
+
+		os.Setenv("GO111MODULE", "auto")
+		assert.Equal(t, "modpkg/graph", MustDir2Import(tmp+"/src/modpkg/graph"))
+		assert.Equal(t, "example.com/svc/graph", MustDir2Import(tmp+"/mono/svc/graph"))
+
+		os.Setenv("GO111MODULE", "")
+		assert.Equal(t, "modpkg/graph", MustDir2Import(tmp+"/src/modpkg/graph"))
+	})
+}
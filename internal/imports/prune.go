@@ -5,13 +5,13 @@ package imports
 import (
 	"bytes"
 	"go/ast"
-	"go/build"
 	"go/parser"
 	"go/printer"
 	"go/token"
 	"path/filepath"
 	"strings"
 
+	"github.com/99designs/gqlgen/internal/gopath"
 	"golang.org/x/tools/imports"
 
 	"golang.org/x/tools/go/ast/astutil"
@@ -110,7 +110,7 @@ func getUnusedImports(file ast.Node, filename string) (map[string]string, error)
 }
 
 func importPathToName(importPath, srcDir string) (packageName string) {
-	pkg, err := build.Default.Import(importPath, srcDir, 0)
+	pkg, err := gopath.Import(importPath, srcDir, 0)
 	if err != nil {
 		return ""
 	}